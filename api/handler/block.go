@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/blockchain-sdk-go/client"
+	"github.com/gin-gonic/gin"
+)
+
+// GetBlockHeight 查詢目前區塊高度
+// @Summary Get current block height
+// @Description Get the latest block number of the connected chain
+// @Tags ethereum, tron
+// @Produce json
+// @Success 200 {object} types.Response{data=types.BlockHeightResponse}
+// @Router /eth/block/height [post]
+// @Router /tron/block/height [post]
+func (h *BlockchainHandler) GetBlockHeight(c *gin.Context) {
+	blockReader, ok := clientCapability[types.BlockReader](h, c, types.CapabilityBlocks, "Block queries not supported")
+	if !ok {
+		return
+	}
+
+	number, err := blockReader.GetBlockNumber(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to get block height",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Block height retrieved successfully",
+		Data:    types.BlockHeightResponse{Number: number},
+	})
+}
+
+// GetBlock 查詢區塊
+// @Summary Get block
+// @Description Get a block by number, hash or "latest"
+// @Tags ethereum, tron
+// @Accept json
+// @Produce json
+// @Param request body types.BlockRequest true "Block query details"
+// @Success 200 {object} types.Response{data=types.BlockResponse}
+// @Router /eth/block [post]
+// @Router /tron/block [post]
+func (h *BlockchainHandler) GetBlock(c *gin.Context) {
+	var req types.BlockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	blockReader, ok := clientCapability[types.BlockReader](h, c, types.CapabilityBlocks, "Block queries not supported")
+	if !ok {
+		return
+	}
+
+	block, err := blockReader.GetBlock(c.Request.Context(), req.Block)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to get block",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Block retrieved successfully",
+		Data:    block,
+	})
+}
+
+// GetTransaction 查詢交易
+// @Summary Get transaction
+// @Description Get a transaction by hash
+// @Tags ethereum, tron
+// @Accept json
+// @Produce json
+// @Param request body types.TransactionQueryRequest true "Transaction query details"
+// @Success 200 {object} types.Response{data=types.TransactionDetailResponse}
+// @Router /eth/tx [post]
+// @Router /tron/tx [post]
+func (h *BlockchainHandler) GetTransaction(c *gin.Context) {
+	var req types.TransactionQueryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if err := client.ValidateTxHash(req.TxHash); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid transaction hash",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	blockReader, ok := clientCapability[types.BlockReader](h, c, types.CapabilityBlocks, "Block queries not supported")
+	if !ok {
+		return
+	}
+
+	tx, err := blockReader.GetTransaction(c.Request.Context(), req.TxHash)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to get transaction",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Transaction retrieved successfully",
+		Data:    tx,
+	})
+}
+
+// GetTransactionReceipt 查詢交易收據
+// @Summary Get transaction receipt
+// @Description Get the execution receipt of a transaction by hash
+// @Tags ethereum, tron
+// @Accept json
+// @Produce json
+// @Param request body types.TransactionQueryRequest true "Transaction query details"
+// @Success 200 {object} types.Response{data=types.ReceiptResponse}
+// @Router /eth/tx/receipt [post]
+// @Router /tron/tx/receipt [post]
+func (h *BlockchainHandler) GetTransactionReceipt(c *gin.Context) {
+	var req types.TransactionQueryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if err := client.ValidateTxHash(req.TxHash); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid transaction hash",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	blockReader, ok := clientCapability[types.BlockReader](h, c, types.CapabilityBlocks, "Block queries not supported")
+	if !ok {
+		return
+	}
+
+	receipt, err := blockReader.GetTransactionReceipt(c.Request.Context(), req.TxHash)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to get transaction receipt",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Transaction receipt retrieved successfully",
+		Data:    receipt,
+	})
+}
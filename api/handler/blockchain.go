@@ -29,9 +29,15 @@ func NewBlockchainHandler(blockchainType client.BlockchainType, nodeURL string)
 	return &BlockchainHandler{client: c, nodeURL: nodeURL}, nil
 }
 
+// NewInstanceHandler 建立區塊鏈實例的 handler
+// instance：註冊表建立的實例，自動連線使用 instance.NodeURL
+func NewInstanceHandler(instance client.Instance) *BlockchainHandler {
+	return &BlockchainHandler{client: instance.Client, nodeURL: instance.NodeURL}
+}
+
 // Connect 連接區塊鏈節點
 // @Summary Connect to blockchain node
-// @Description Connect to a blockchain node using the provided URL
+// @Description Connect to a blockchain node using the provided URL; Tron also accepts TLS, API key, header and dial timeout options
 // @Tags ethereum, tron
 // @Accept json
 // @Produce json
@@ -50,7 +56,19 @@ func (h *BlockchainHandler) Connect(c *gin.Context) {
 		return
 	}
 
-	if err := h.client.Connect(c.Request.Context(), req.URL); err != nil {
+	var err error
+	if configurer, ok := h.client.(types.ConnectionConfigurer); ok {
+		err = configurer.ConnectWithOptions(c.Request.Context(), req)
+	} else if req.TLS != nil || req.APIKey != "" || len(req.Headers) > 0 || req.DialTimeoutSeconds > 0 {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Connection options not supported",
+		})
+		return
+	} else {
+		err = h.client.Connect(c.Request.Context(), req.URL)
+	}
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, types.Response{
 			Code:    http.StatusInternalServerError,
 			Message: "Failed to connect",
@@ -74,12 +92,8 @@ func (h *BlockchainHandler) Connect(c *gin.Context) {
 // @Router /eth/wallet/generate [post]
 // @Router /tron/wallet/generate [post]
 func (h *BlockchainHandler) GenerateWallet(c *gin.Context) {
-	walletManager, ok := h.client.(types.WalletManager)
+	walletManager, ok := clientCapability[types.WalletManager](h, c, types.CapabilityWallet, "Wallet operations not supported")
 	if !ok {
-		c.JSON(http.StatusInternalServerError, types.Response{
-			Code:    http.StatusInternalServerError,
-			Message: "Wallet operations not supported",
-		})
 		return
 	}
 
@@ -124,12 +138,12 @@ func (h *BlockchainHandler) GetBalance(c *gin.Context) {
 		return
 	}
 
-	tokenManager, ok := h.client.(types.TokenManager)
+	if !h.validateAddresses(c, &req.Address) {
+		return
+	}
+
+	tokenManager, ok := clientCapability[types.TokenManager](h, c, types.CapabilityNativeTransfer, "Token operations not supported")
 	if !ok {
-		c.JSON(http.StatusInternalServerError, types.Response{
-			Code:    http.StatusInternalServerError,
-			Message: "Token operations not supported",
-		})
 		return
 	}
 
@@ -156,7 +170,7 @@ func (h *BlockchainHandler) GetBalance(c *gin.Context) {
 
 // SendNativeToken 發送主鏈幣
 // @Summary Send native tokens
-// @Description Send native tokens (ETH/TRX) to an address
+// @Description Send native tokens (ETH/TRX) to an address; Tron accepts an optional memo written to raw_data.data
 // @Tags ethereum, tron
 // @Accept json
 // @Produce json
@@ -175,17 +189,27 @@ func (h *BlockchainHandler) SendNativeToken(c *gin.Context) {
 		return
 	}
 
-	tokenManager, ok := h.client.(types.TokenManager)
+	if !h.validateAddresses(c, &req.ToAddress) {
+		return
+	}
+
+	tokenManager, ok := clientCapability[types.TokenManager](h, c, types.CapabilityNativeTransfer, "Token operations not supported")
 	if !ok {
-		c.JSON(http.StatusInternalServerError, types.Response{
-			Code:    http.StatusInternalServerError,
-			Message: "Token operations not supported",
-		})
 		return
 	}
 
 	amount := new(big.Float).SetFloat64(req.Amount)
-	txHash, err := tokenManager.SendNativeToken(c.Request.Context(), req.FromPrivateKey, req.ToAddress, amount)
+	var txHash string
+	var err error
+	if req.Memo != "" {
+		memoTransferer, ok := h.memoTransferer(c)
+		if !ok {
+			return
+		}
+		txHash, err = memoTransferer.SendNativeTokenWithMemo(c.Request.Context(), req.FromPrivateKey, req.ToAddress, amount, req.Memo)
+	} else {
+		txHash, err = tokenManager.SendNativeToken(c.Request.Context(), req.FromPrivateKey, req.ToAddress, amount)
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, types.Response{
 			Code:    http.StatusInternalServerError,
@@ -204,6 +228,11 @@ func (h *BlockchainHandler) SendNativeToken(c *gin.Context) {
 	})
 }
 
+// memoTransferer 取得支援備註的 client，不支援時回應 501
+func (h *BlockchainHandler) memoTransferer(c *gin.Context) (types.MemoTransferer, bool) {
+	return clientCapability[types.MemoTransferer](h, c, types.CapabilityMemo, "Memo not supported")
+}
+
 // DeployContract 部署智能合約
 // @Summary Deploy smart contract
 // @Description Deploy a new smart contract to the blockchain
@@ -225,21 +254,18 @@ func (h *BlockchainHandler) DeployContract(c *gin.Context) {
 		return
 	}
 
-	contractManager, ok := h.client.(types.ContractManager)
+	contractManager, ok := clientCapability[types.ContractManager](h, c, types.CapabilityContractDeploy, "Contract operations not supported")
 	if !ok {
-		c.JSON(http.StatusInternalServerError, types.Response{
-			Code:    http.StatusInternalServerError,
-			Message: "Contract operations not supported",
-		})
 		return
 	}
 
-	contractAddress, err := contractManager.DeployContract(
+	contractAddress, txHash, err := contractManager.DeployContract(
 		c.Request.Context(),
 		req.PrivateKey,
 		req.Bytecode,
 		req.ABI,
 		req.ConstructorArgs,
+		req.DeployOptions,
 	)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, types.Response{
@@ -255,15 +281,186 @@ func (h *BlockchainHandler) DeployContract(c *gin.Context) {
 		Message: "Contract deployed successfully",
 		Data: types.ContractResponse{
 			ContractAddress: contractAddress,
+			TxHash:          txHash,
+		},
+	})
+}
+
+// CallContract 呼叫智能合約唯讀方法
+// @Summary Call contract method
+// @Description Call a read-only contract method and decode the result with the supplied ABI
+// @Tags ethereum, tron
+// @Accept json
+// @Produce json
+// @Param request body types.ContractCallRequest true "Contract call details"
+// @Success 200 {object} types.Response{data=types.ContractResponse}
+// @Router /eth/contract/call [post]
+// @Router /tron/contract/call [post]
+func (h *BlockchainHandler) CallContract(c *gin.Context) {
+	var req types.ContractCallRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if !h.validateAddresses(c, &req.ContractAddress) {
+		return
+	}
+
+	contractManager, ok := clientCapability[types.ContractManager](h, c, types.CapabilityContractCall, "Contract operations not supported")
+	if !ok {
+		return
+	}
+
+	result, err := contractManager.CallContract(c.Request.Context(), req.ContractAddress, req.ABI, req.Method, req.Params)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to call contract",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Contract called successfully",
+		Data: types.ContractResponse{
+			ContractAddress: req.ContractAddress,
+			Result:          result,
 		},
 	})
 }
 
+// ValidateAddress 驗證地址
+// @Summary Validate address
+// @Description Strictly validate an address and return its normalized form (EIP-55 for Ethereum, base58 for Tron; Tron also accepts 41-prefixed hex and 0x EVM forms)
+// @Tags ethereum, tron
+// @Accept json
+// @Produce json
+// @Param request body types.AddressValidateRequest true "Address to validate"
+// @Success 200 {object} types.Response{data=types.AddressValidateResponse}
+// @Router /eth/address/validate [post]
+// @Router /tron/address/validate [post]
+func (h *BlockchainHandler) ValidateAddress(c *gin.Context) {
+	var req types.AddressValidateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	validator, ok := clientCapability[types.AddressValidator](h, c, types.CapabilityWallet, "Address validation not supported")
+	if !ok {
+		return
+	}
+
+	resp := types.AddressValidateResponse{Address: req.Address}
+	normalized, err := validator.ValidateAddress(req.Address)
+	if err != nil {
+		resp.Reason = err.Error()
+	} else {
+		resp.Valid = true
+		resp.Normalized = normalized
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Address validated",
+		Data:    resp,
+	})
+}
+
+// ConvertAddress 轉換波場地址格式
+// @Summary Convert Tron address
+// @Description Convert a Tron address given as base58, 41-prefixed hex or 0x EVM form into all three forms, validating checksums
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.AddressValidateRequest true "Address to convert"
+// @Success 200 {object} types.Response{data=types.TronAddressResponse}
+// @Router /tron/address/convert [post]
+func (h *BlockchainHandler) ConvertAddress(c *gin.Context) {
+	var req types.AddressValidateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	converter, ok := clientCapability[types.TronAddressConverter](h, c, types.CapabilityAddressConversion, "Address conversion not supported")
+	if !ok {
+		return
+	}
+
+	forms, err := converter.ConvertAddress(req.Address)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid address",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Address converted successfully",
+		Data:    forms,
+	})
+}
+
+// validateAddresses 依 client 的地址規則驗證並就地正規化地址欄位
+// 任一地址不合法時回應 400 並回傳 false，避免錯誤地址進入 RPC 呼叫
+func (h *BlockchainHandler) validateAddresses(c *gin.Context, addrs ...*string) bool {
+	validator, ok := h.client.(types.AddressValidator)
+	if !ok {
+		return true
+	}
+	for _, addr := range addrs {
+		normalized, err := validator.ValidateAddress(*addr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.Response{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid address",
+				Data:    types.ErrorResponse{Error: err.Error()},
+			})
+			return false
+		}
+		*addr = normalized
+	}
+	return true
+}
+
 // ConnectByURL 供服务端自动连接节点
 func (h *BlockchainHandler) ConnectByURL(url string) error {
 	return h.client.Connect(context.Background(), url)
 }
 
+// AutoConnect 使用建立 handler 時設定的節點位址连接；EVM 網路會依序嘗試設定的 RPC 位址
+func (h *BlockchainHandler) AutoConnect() error {
+	return h.client.Connect(context.Background(), h.nodeURL)
+}
+
+// NetworkInfo 回傳 handler 所服務網路的資訊
+func (h *BlockchainHandler) NetworkInfo() (types.NetworkResponse, bool) {
+	provider, ok := h.client.(types.NetworkInfoProvider)
+	if !ok {
+		return types.NetworkResponse{}, false
+	}
+	return provider.NetworkInfo(), true
+}
+
 // Close 关闭区块链连接
 func (h *BlockchainHandler) Close() {
 	h.client.Close()
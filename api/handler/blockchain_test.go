@@ -1,13 +1,35 @@
 package handler
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/blockchain-sdk-go/client"
 	"github.com/gin-gonic/gin"
 )
 
+// newTestHandler 與 cmd/api 相同，經由註冊表建立實例後取第一個實例的 handler
+func newTestHandler(t *testing.T, blockchainType client.BlockchainType) *BlockchainHandler {
+	t.Helper()
+	chain, ok := client.LookupChain(blockchainType)
+	if !ok {
+		t.Fatalf("chain %s not registered", blockchainType)
+	}
+	instances, err := chain.NewInstances()
+	if err != nil {
+		t.Fatalf("%s: NewInstances failed: %v", blockchainType, err)
+	}
+	if len(instances) == 0 {
+		t.Fatalf("%s: no instances", blockchainType)
+	}
+	return NewInstanceHandler(instances[0])
+}
+
 func TestPingRoute(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	r := gin.Default()
@@ -24,4 +46,155 @@ func TestPingRoute(t *testing.T) {
 	}
 }
 
+func TestGetBalanceRejectsInvalidAddress(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := newTestHandler(t, client.Ethereum)
+	r := gin.New()
+	r.POST("/eth/balance", h.GetBalance)
+
+	// 未連線的 client 若收到合法地址會回 500，非法地址須在 RPC 前回 400
+	body := `{"address":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD"}`
+	req, _ := http.NewRequest("POST", "/eth/balance", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
+
+func TestConvertTronAddress(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := newTestHandler(t, client.Tron)
+	r := gin.New()
+	r.POST("/tron/address/convert", h.ConvertAddress)
+
+	for body, want := range map[string]int{
+		`{"address":"0xa614f803b6fd780986a42c78ec9c7f77e6ded13c"}`: http.StatusOK,
+		`{"address":"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u"}`:         http.StatusBadRequest,
+	} {
+		req, _ := http.NewRequest("POST", "/tron/address/convert", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != want {
+			t.Errorf("%s: expected status %d, got %d", body, want, w.Code)
+		}
+		if want == http.StatusOK && !strings.Contains(w.Body.String(), "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t") {
+			t.Errorf("expected base58 form in response, got %s", w.Body.String())
+		}
+	}
+}
+
+func TestConnectRejectsUnsupportedOptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := newTestHandler(t, client.Ethereum)
+	r := gin.New()
+	r.POST("/eth/connect", h.Connect)
+
+	body := `{"url":"https://rpc.example","api_key":"k"}`
+	req, _ := http.NewRequest("POST", "/eth/connect", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
+
 // TODO: 可根据实际 handler 继续补充 POST /api/v1/xxx 路由的测试
+
+func TestGetTransactionRejectsInvalidHash(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := newTestHandler(t, client.Ethereum)
+	r := gin.New()
+	r.POST("/eth/tx", h.GetTransaction)
+	r.POST("/eth/tx/receipt", h.GetTransactionReceipt)
+
+	// 不足 32 bytes 的雜湊須回 400，不可補零後查詢
+	for _, path := range []string{"/eth/tx", "/eth/tx/receipt"} {
+		req, _ := http.NewRequest("POST", path, strings.NewReader(`{"tx_hash":"0x12"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status 400, got %d", path, w.Code)
+		}
+	}
+}
+
+func TestDeployContractRejectsResourcePercent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := newTestHandler(t, client.Tron)
+	r := gin.New()
+	r.POST("/tron/contract/deploy", h.DeployContract)
+
+	// 範圍外的比例須在送往節點前回 400；範圍內時未連線的 client 回 500
+	for percent, want := range map[string]int{"-1": http.StatusBadRequest, "101": http.StatusBadRequest, "50": http.StatusInternalServerError} {
+		body := `{"private_key":"01","bytecode":"00","abi":"[]","consume_user_resource_percent":` + percent + `}`
+		req, _ := http.NewRequest("POST", "/tron/contract/deploy", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != want {
+			t.Errorf("percent %s: expected status %d, got %d: %s", percent, want, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestUpdateAccountPermissionRejectsInvalidKeyAddress(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := newTestHandler(t, client.Tron)
+	r := gin.New()
+	r.POST("/tron/permission/update", h.UpdateAccountPermission)
+
+	valid := `{"address":"TJRabPrwbZy45sbavfcjinPJC18kjpRTv8","weight":1}`
+	invalid := `{"address":"TJRabPrwbZy45sbavfcjinPJC18kjpRTv9","weight":1}`
+	// 未連線的 client 收到合法地址會回 500，非法的簽名地址須在呼叫節點前回 400
+	for _, tt := range []struct {
+		owner, active string
+		want          int
+	}{
+		{valid, valid, http.StatusInternalServerError},
+		{invalid, valid, http.StatusBadRequest},
+		{valid, invalid, http.StatusBadRequest},
+	} {
+		body := `{"private_key":"01","owner":{"threshold":1,"keys":[` + tt.owner + `]},"actives":[{"threshold":1,"keys":[` + tt.active + `]}]}`
+		req, _ := http.NewRequest("POST", "/tron/permission/update", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != tt.want {
+			t.Errorf("%s: expected status %d, got %d: %s", body, tt.want, w.Code, w.Body.String())
+		}
+	}
+}
+
+// fakeMultiSigClient 測試用 client，CreateMultiSigTransaction 一律回報不支援的權限，其餘方法未實作
+type fakeMultiSigClient struct {
+	fakeSubscriber
+	types.TronMultiSigManager
+}
+
+func (f *fakeMultiSigClient) CreateMultiSigTransaction(ctx context.Context, req types.TronMultiSigCreateRequest) (types.TronMultiSigTransactionResponse, error) {
+	return types.TronMultiSigTransactionResponse{}, fmt.Errorf("%w: permission id %d", client.ErrUnsupportedPermission, req.PermissionID)
+}
+
+func TestCreateMultiSigTransactionRejectsUnsupportedPermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := &BlockchainHandler{client: &fakeMultiSigClient{}}
+	r := gin.New()
+	r.POST("/tron/multisig/create", h.CreateMultiSigTransaction)
+
+	body := `{"owner_address":"TJRabPrwbZy45sbavfcjinPJC18kjpRTv8","to_address":"TVjsyZ7fYF3qLF6BQgPmTEZy1xrNNyVAAA","amount":"5","permission_id":1}`
+	req, _ := http.NewRequest("POST", "/tron/multisig/create", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "Invalid permission") {
+		t.Errorf("expected 400 for unsupported permission, got %d: %s", w.Code, w.Body.String())
+	}
+}
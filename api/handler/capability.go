@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/gin-gonic/gin"
+)
+
+// GetCapabilities 查詢區塊鏈 client 提供的功能
+// @Summary Get capabilities
+// @Description List the capabilities declared by the chain client; endpoints of other capabilities respond 501 with code unsupported_capability
+// @Tags blockchain
+// @Produce json
+// @Success 200 {object} types.Response{data=types.CapabilitiesResponse}
+// @Router /eth/capabilities [get]
+// @Router /tron/capabilities [get]
+func (h *BlockchainHandler) GetCapabilities(c *gin.Context) {
+	resp := types.CapabilitiesResponse{Capabilities: h.Capabilities()}
+	if info, ok := h.NetworkInfo(); ok {
+		resp.Network = info.Name
+		resp.Connected = info.Connected
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Capabilities retrieved successfully",
+		Data:    resp,
+	})
+}
+
+// Capabilities 回傳 client 宣告的功能，未宣告時為空集合
+func (h *BlockchainHandler) Capabilities() types.CapabilitySet {
+	provider, ok := h.client.(types.CapabilityProvider)
+	if !ok {
+		return types.CapabilitySet{}
+	}
+	return provider.Capabilities()
+}
+
+// supports 檢查 client 是否提供指定功能，未宣告功能集合的 client 僅依實作的介面判斷
+func (h *BlockchainHandler) supports(capability types.Capability) bool {
+	provider, ok := h.client.(types.CapabilityProvider)
+	return !ok || provider.Capabilities().Has(capability)
+}
+
+// clientCapability 取得 client 實作的功能介面
+// client 未宣告該功能或未實作介面時回應 501 與 unsupported_capability 錯誤代碼
+func clientCapability[T any](h *BlockchainHandler, c *gin.Context, capability types.Capability, message string) (T, bool) {
+	impl, ok := h.client.(T)
+	if ok && h.supports(capability) {
+		return impl, true
+	}
+	notSupported(c, capability, message)
+	var zero T
+	return zero, false
+}
+
+// notSupported 回應 501 與 unsupported_capability 錯誤代碼
+func notSupported(c *gin.Context, capability types.Capability, message string) {
+	c.JSON(http.StatusNotImplemented, types.Response{
+		Code:    http.StatusNotImplemented,
+		Message: message,
+		Data: types.ErrorResponse{
+			Error:      fmt.Sprintf("capability %q is not supported by this chain", capability),
+			Code:       types.ErrCodeUnsupportedCapability,
+			Capability: capability,
+		},
+	})
+}
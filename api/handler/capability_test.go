@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/blockchain-sdk-go/client"
+	"github.com/gin-gonic/gin"
+)
+
+// fakeCapabilityClient 測試用 client，實作訂閱介面但未宣告 events 功能
+type fakeCapabilityClient struct {
+	fakeSubscriber
+}
+
+func (f *fakeCapabilityClient) Capabilities() types.CapabilitySet {
+	return types.CapabilitySet{types.CapabilityBlocks}
+}
+
+// unsupportedError 解析 501 回應的錯誤內容
+func unsupportedError(t *testing.T, w *httptest.ResponseRecorder) types.ErrorResponse {
+	t.Helper()
+	if w.Code != http.StatusNotImplemented {
+		t.Fatalf("expected 501, got %d: %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		Code int                 `json:"code"`
+		Data types.ErrorResponse `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Code != http.StatusNotImplemented || resp.Data.Code != types.ErrCodeUnsupportedCapability {
+		t.Errorf("unexpected error response: %s", w.Body.String())
+	}
+	return resp.Data
+}
+
+func TestGetCapabilities(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := newTestHandler(t, client.Tron)
+	r := gin.New()
+	r.GET("/tron/capabilities", h.GetCapabilities)
+
+	req, _ := http.NewRequest("GET", "/tron/capabilities", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	var resp struct {
+		Data types.CapabilitiesResponse `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []types.Capability{types.CapabilityNativeTransfer, types.CapabilityEvents, types.CapabilityOfflineSigning} {
+		if !resp.Data.Capabilities.Has(c) {
+			t.Errorf("expected capability %q in %v", c, resp.Data.Capabilities)
+		}
+	}
+	if resp.Data.Capabilities.Has(types.CapabilityPermit) || resp.Data.Connected {
+		t.Errorf("unexpected capabilities response: %+v", resp.Data)
+	}
+}
+
+func TestUnsupportedCapability(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// 未實作介面
+	h := &BlockchainHandler{client: &fakeSubscriber{}}
+	r := gin.New()
+	r.POST("/eth/wallet/generate", h.GenerateWallet)
+	req, _ := http.NewRequest("POST", "/eth/wallet/generate", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if got := unsupportedError(t, w).Capability; got != types.CapabilityWallet {
+		t.Errorf("expected capability wallet, got %q", got)
+	}
+
+	// 實作介面但未宣告功能
+	h = &BlockchainHandler{client: &fakeCapabilityClient{}}
+	r = gin.New()
+	r.GET("/eth/stream/blocks", h.StreamBlocks)
+	req, _ = http.NewRequest("GET", "/eth/stream/blocks", nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if got := unsupportedError(t, w).Capability; got != types.CapabilityEvents {
+		t.Errorf("expected capability events, got %q", got)
+	}
+
+	// EVM 不支援轉帳備註
+	eth := newTestHandler(t, client.Ethereum)
+	r = gin.New()
+	r.POST("/eth/transfer/native", eth.SendNativeToken)
+	body := `{"from_private_key":"0x01","to_address":"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed","amount":1,"memo":"hi"}`
+	req, _ = http.NewRequest("POST", "/eth/transfer/native", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if got := unsupportedError(t, w).Capability; got != types.CapabilityMemo {
+		t.Errorf("expected capability memo, got %q", got)
+	}
+}
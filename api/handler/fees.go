@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/gin-gonic/gin"
+)
+
+// GetGasFees 查詢手續費建議
+// @Summary Get gas fee suggestions
+// @Description Get slow, standard and fast fee tiers sampled from eth_feeHistory, with estimated inclusion time
+// @Tags ethereum
+// @Produce json
+// @Success 200 {object} types.Response{data=types.GasFeesResponse}
+// @Router /eth/fees [post]
+func (h *BlockchainHandler) GetGasFees(c *gin.Context) {
+	oracle, ok := clientCapability[types.GasFeeOracle](h, c, types.CapabilityGasFees, "Fee oracle not supported")
+	if !ok {
+		return
+	}
+
+	fees, err := oracle.GetGasFees(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to get gas fees",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Gas fees retrieved successfully",
+		Data:    fees,
+	})
+}
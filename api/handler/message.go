@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/gin-gonic/gin"
+)
+
+// SignMessage 簽署鏈下訊息
+// @Summary Sign message
+// @Description Sign a UTF-8 message with the chain's message prefix: EIP-191 personal_sign for EVM networks, TIP-191 signMessageV2 for Tron
+// @Tags ethereum, tron
+// @Accept json
+// @Produce json
+// @Param request body types.MessageSignRequest true "Signer and message"
+// @Success 200 {object} types.Response{data=types.MessageSignatureResponse}
+// @Router /eth/message/sign [post]
+// @Router /tron/message/sign [post]
+func (h *BlockchainHandler) SignMessage(c *gin.Context) {
+	var req types.MessageSignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	messageSigner, ok := h.messageSigner(c)
+	if !ok {
+		return
+	}
+
+	sig, err := messageSigner.SignMessage(c.Request.Context(), req.PrivateKey, req.Message)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Failed to sign message",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Message signed successfully",
+		Data:    sig,
+	})
+}
+
+// VerifyMessage 驗證鏈下訊息簽名
+// @Summary Verify message signature
+// @Description Recover the signer address of a message signature; when address is given, valid reports whether it signed the message
+// @Tags ethereum, tron
+// @Accept json
+// @Produce json
+// @Param request body types.MessageVerifyRequest true "Message and signature"
+// @Success 200 {object} types.Response{data=types.MessageVerifyResponse}
+// @Router /eth/message/verify [post]
+// @Router /tron/message/verify [post]
+func (h *BlockchainHandler) VerifyMessage(c *gin.Context) {
+	var req types.MessageVerifyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if req.Address != "" && !h.validateAddresses(c, &req.Address) {
+		return
+	}
+
+	messageSigner, ok := h.messageSigner(c)
+	if !ok {
+		return
+	}
+
+	signer, err := messageSigner.VerifyMessage(c.Request.Context(), req.Message, req.Signature)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid signature",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Signature verified successfully",
+		Data:    types.MessageVerifyResponse{Address: signer, Valid: req.Address == "" || req.Address == signer},
+	})
+}
+
+// messageSigner 取得 client 的訊息簽名操作，不支援時回應錯誤
+func (h *BlockchainHandler) messageSigner(c *gin.Context) (types.MessageSigner, bool) {
+	return clientCapability[types.MessageSigner](h, c, types.CapabilityMessageSigning, "Message signing not supported")
+}
@@ -0,0 +1,255 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/blockchain-sdk-go/client"
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateAccountPermission 更新波場帳戶權限
+// @Summary Update Tron account permissions
+// @Description Replace the owner and active permissions of an account with weighted keys and thresholds; burns the chain permission update fee
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TronPermissionUpdateRequest true "Permission settings"
+// @Success 200 {object} types.Response{data=types.TransactionResponse}
+// @Router /tron/permission/update [post]
+func (h *BlockchainHandler) UpdateAccountPermission(c *gin.Context) {
+	var req types.TronPermissionUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	// 權限中的簽名地址與其他請求相同，於呼叫節點前驗證並正規化
+	var addrs []*string
+	for i := range req.Owner.Keys {
+		addrs = append(addrs, &req.Owner.Keys[i].Address)
+	}
+	for i := range req.Actives {
+		for j := range req.Actives[i].Keys {
+			addrs = append(addrs, &req.Actives[i].Keys[j].Address)
+		}
+	}
+	if !h.validateAddresses(c, addrs...) {
+		return
+	}
+
+	multiSigManager, ok := h.multiSigManager(c)
+	if !ok {
+		return
+	}
+
+	txHash, err := multiSigManager.UpdateAccountPermission(c.Request.Context(), req.PrivateKey, req.Owner, req.Actives)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to update account permission",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Account permission updated successfully",
+		Data:    types.TransactionResponse{TxHash: txHash},
+	})
+}
+
+// CreateMultiSigTransaction 建立多簽交易
+// @Summary Create multi-signature transaction
+// @Description Create an unsigned TRX or TRC20 transfer with the given permission_id, hex encoded for signers
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TronMultiSigCreateRequest true "Transaction details"
+// @Success 200 {object} types.Response{data=types.TronMultiSigTransactionResponse}
+// @Router /tron/multisig/create [post]
+func (h *BlockchainHandler) CreateMultiSigTransaction(c *gin.Context) {
+	var req types.TronMultiSigCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	addrs := []*string{&req.OwnerAddress, &req.ToAddress}
+	if req.ContractAddress != "" {
+		addrs = append(addrs, &req.ContractAddress)
+	}
+	if !h.validateAddresses(c, addrs...) {
+		return
+	}
+
+	multiSigManager, ok := h.multiSigManager(c)
+	if !ok {
+		return
+	}
+
+	tx, err := multiSigManager.CreateMultiSigTransaction(c.Request.Context(), req)
+	if errors.Is(err, client.ErrUnsupportedPermission) {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid permission",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to create multi-signature transaction",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Multi-signature transaction created successfully",
+		Data:    tx,
+	})
+}
+
+// SignMultiSigTransaction 附加多簽簽名
+// @Summary Sign multi-signature transaction
+// @Description Append a signature to a hex encoded transaction and return the current sign weight
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TronMultiSigSignRequest true "Signer and transaction"
+// @Success 200 {object} types.Response{data=types.TronMultiSigTransactionResponse}
+// @Router /tron/multisig/sign [post]
+func (h *BlockchainHandler) SignMultiSigTransaction(c *gin.Context) {
+	var req types.TronMultiSigSignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	multiSigManager, ok := h.multiSigManager(c)
+	if !ok {
+		return
+	}
+
+	tx, err := multiSigManager.SignMultiSigTransaction(c.Request.Context(), req.PrivateKey, req.Transaction)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to sign multi-signature transaction",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Transaction signed successfully",
+		Data:    tx,
+	})
+}
+
+// GetTransactionSignWeight 查詢多簽交易簽名權重
+// @Summary Get transaction sign weight
+// @Description Get the current sign weight and threshold of a hex encoded transaction
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TronMultiSigTransactionRequest true "Hex encoded transaction"
+// @Success 200 {object} types.Response{data=types.TronSignWeightResponse}
+// @Router /tron/multisig/weight [post]
+func (h *BlockchainHandler) GetTransactionSignWeight(c *gin.Context) {
+	var req types.TronMultiSigTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	multiSigManager, ok := h.multiSigManager(c)
+	if !ok {
+		return
+	}
+
+	weight, err := multiSigManager.GetTransactionSignWeight(c.Request.Context(), req.Transaction)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to get sign weight",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Sign weight retrieved successfully",
+		Data:    weight,
+	})
+}
+
+// BroadcastMultiSigTransaction 廣播多簽交易
+// @Summary Broadcast multi-signature transaction
+// @Description Broadcast a hex encoded transaction once its sign weight reaches the permission threshold
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TronMultiSigTransactionRequest true "Hex encoded transaction"
+// @Success 200 {object} types.Response{data=types.TransactionResponse}
+// @Router /tron/multisig/broadcast [post]
+func (h *BlockchainHandler) BroadcastMultiSigTransaction(c *gin.Context) {
+	var req types.TronMultiSigTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	multiSigManager, ok := h.multiSigManager(c)
+	if !ok {
+		return
+	}
+
+	txHash, err := multiSigManager.BroadcastMultiSigTransaction(c.Request.Context(), req.Transaction)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to broadcast multi-signature transaction",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Transaction broadcast successfully",
+		Data:    types.TransactionResponse{TxHash: txHash},
+	})
+}
+
+// multiSigManager 取得 client 的多簽操作，不支援時回應錯誤
+func (h *BlockchainHandler) multiSigManager(c *gin.Context) (types.TronMultiSigManager, bool) {
+	return clientCapability[types.TronMultiSigManager](h, c, types.CapabilityMultiSig, "Multi-signature not supported")
+}
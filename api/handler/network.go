@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/blockchain-sdk-go/client"
+	"github.com/gin-gonic/gin"
+)
+
+// NetworkHandler 網路列表 API 處理器
+// handlers：各網路的區塊鏈 handler
+type NetworkHandler struct {
+	handlers []*BlockchainHandler
+}
+
+// NewNetworkHandler 建立網路列表 handler
+func NewNetworkHandler(handlers ...*BlockchainHandler) *NetworkHandler {
+	return &NetworkHandler{handlers: handlers}
+}
+
+// ListNetworks 列出已設定的網路
+// @Summary List networks
+// @Description List configured networks with chain ID, native symbol, fee model and connection status
+// @Tags networks
+// @Produce json
+// @Success 200 {object} types.Response{data=[]types.NetworkResponse}
+// @Router /networks [get]
+func (h *NetworkHandler) ListNetworks(c *gin.Context) {
+	networks := make([]types.NetworkResponse, 0, len(h.handlers))
+	for _, bh := range h.handlers {
+		if info, ok := bh.NetworkInfo(); ok {
+			networks = append(networks, info)
+		}
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Networks retrieved successfully",
+		Data:    networks,
+	})
+}
+
+// ListChains 列出已註冊的區塊鏈實作
+// @Summary List chains
+// @Description List registered chain implementations and the capabilities used to generate their routes
+// @Tags networks
+// @Produce json
+// @Success 200 {object} types.Response{data=[]types.ChainResponse}
+// @Router /chains [get]
+func (h *NetworkHandler) ListChains(c *gin.Context) {
+	registered := client.Chains()
+	chains := make([]types.ChainResponse, 0, len(registered))
+	for _, chain := range registered {
+		chains = append(chains, types.ChainResponse{
+			Type:         string(chain.Type),
+			Description:  chain.Description,
+			Capabilities: chain.Capabilities,
+		})
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Chains retrieved successfully",
+		Data:    chains,
+	})
+}
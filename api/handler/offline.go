@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/gin-gonic/gin"
+)
+
+// GetRefBlock 取得離線交易使用的 ref block
+// @Summary Get Tron ref block
+// @Description Get the latest block number, id and timestamp as the ref block snapshot for offline transaction building
+// @Tags tron
+// @Produce json
+// @Success 200 {object} types.Response{data=types.TronRefBlock}
+// @Router /tron/offline/refblock [post]
+func (h *BlockchainHandler) GetRefBlock(c *gin.Context) {
+	offlineBuilder, ok := h.offlineBuilder(c)
+	if !ok {
+		return
+	}
+
+	ref, err := offlineBuilder.GetRefBlock(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to get ref block",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Ref block retrieved successfully",
+		Data:    ref,
+	})
+}
+
+// BuildOfflineTransaction 建立離線交易
+// @Summary Build offline Tron transaction
+// @Description Build an unsigned TRX or TRC20 transfer from a ref block snapshot without contacting the node; expiration up to 24 hours after the ref block
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TronOfflineBuildRequest true "Ref block and transfer details"
+// @Success 200 {object} types.Response{data=types.TronOfflineTransaction}
+// @Router /tron/offline/build [post]
+func (h *BlockchainHandler) BuildOfflineTransaction(c *gin.Context) {
+	var req types.TronOfflineBuildRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	addrs := []*string{&req.OwnerAddress, &req.ToAddress}
+	if req.ContractAddress != "" {
+		addrs = append(addrs, &req.ContractAddress)
+	}
+	if !h.validateAddresses(c, addrs...) {
+		return
+	}
+
+	offlineBuilder, ok := h.offlineBuilder(c)
+	if !ok {
+		return
+	}
+
+	tx, err := offlineBuilder.BuildOfflineTransaction(req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Failed to build offline transaction",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Offline transaction built successfully",
+		Data:    tx,
+	})
+}
+
+// SignOfflineTransaction 簽署離線交易
+// @Summary Sign offline Tron transaction
+// @Description Append a signature to a portable transaction without contacting the node; expired transactions are rejected
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TronOfflineSignRequest true "Signer and transaction"
+// @Success 200 {object} types.Response{data=types.TronOfflineTransaction}
+// @Router /tron/offline/sign [post]
+func (h *BlockchainHandler) SignOfflineTransaction(c *gin.Context) {
+	var req types.TronOfflineSignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	offlineBuilder, ok := h.offlineBuilder(c)
+	if !ok {
+		return
+	}
+
+	tx, err := offlineBuilder.SignOfflineTransaction(req.PrivateKey, req.Transaction)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Failed to sign offline transaction",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Transaction signed successfully",
+		Data:    tx,
+	})
+}
+
+// BroadcastOfflineTransaction 廣播離線簽署的交易
+// @Summary Broadcast offline Tron transaction
+// @Description Broadcast a signed portable transaction
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TronOfflineTransactionRequest true "Signed transaction"
+// @Success 200 {object} types.Response{data=types.TransactionResponse}
+// @Router /tron/offline/broadcast [post]
+func (h *BlockchainHandler) BroadcastOfflineTransaction(c *gin.Context) {
+	var req types.TronOfflineTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	offlineBuilder, ok := h.offlineBuilder(c)
+	if !ok {
+		return
+	}
+
+	txHash, err := offlineBuilder.BroadcastOfflineTransaction(c.Request.Context(), req.Transaction)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to broadcast offline transaction",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Transaction broadcast successfully",
+		Data:    types.TransactionResponse{TxHash: txHash},
+	})
+}
+
+// offlineBuilder 取得 client 的離線交易操作，不支援時回應錯誤
+func (h *BlockchainHandler) offlineBuilder(c *gin.Context) (types.TronOfflineBuilder, bool) {
+	return clientCapability[types.TronOfflineBuilder](h, c, types.CapabilityOfflineSigning, "Offline transactions not supported")
+}
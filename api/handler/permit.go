@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"math/big"
+	"net/http"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/gin-gonic/gin"
+)
+
+// SignPermit 簽署 EIP-2612 permit
+// @Summary Sign ERC20 permit
+// @Description Sign an EIP-2612 permit so a relayer can submit a gasless approval
+// @Tags ethereum
+// @Accept json
+// @Produce json
+// @Param request body types.PermitSignRequest true "Permit details"
+// @Success 200 {object} types.Response{data=types.PermitSignatureResponse}
+// @Router /eth/permit/sign [post]
+func (h *BlockchainHandler) SignPermit(c *gin.Context) {
+	var req types.PermitSignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if !h.validateAddresses(c, &req.ContractAddress, &req.Spender) {
+		return
+	}
+
+	value, ok := new(big.Int).SetString(req.Value, 10)
+	if !ok || value.Sign() < 0 || value.BitLen() > 256 {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid value",
+			Data:    types.ErrorResponse{Error: "value must be a uint256 integer in the token's smallest unit"},
+		})
+		return
+	}
+
+	permitSigner, ok := clientCapability[types.PermitSigner](h, c, types.CapabilityPermit, "Permit not supported")
+	if !ok {
+		return
+	}
+
+	sig, err := permitSigner.SignPermit(c.Request.Context(), req.PrivateKey, req.ContractAddress, req.Spender, value, req.Deadline)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to sign permit",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Permit signed successfully",
+		Data:    sig,
+	})
+}
+
+// PermitTransfer 提交 permit 並以 transferFrom 轉出代幣
+// @Summary Submit permit and transferFrom
+// @Description Submit a signed EIP-2612 permit and transfer the approved tokens in one flow
+// @Tags ethereum
+// @Accept json
+// @Produce json
+// @Param request body types.PermitTransferRequest true "Permit transfer details"
+// @Success 200 {object} types.Response{data=types.PermitTransferResponse}
+// @Router /eth/permit/transfer [post]
+func (h *BlockchainHandler) PermitTransfer(c *gin.Context) {
+	var req types.PermitTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if !h.validateAddresses(c, &req.ToAddress, &req.Permit.ContractAddress, &req.Permit.Owner, &req.Permit.Spender) {
+		return
+	}
+
+	permitSigner, ok := clientCapability[types.PermitSigner](h, c, types.CapabilityPermit, "Permit not supported")
+	if !ok {
+		return
+	}
+
+	resp, err := permitSigner.PermitTransfer(c.Request.Context(), req.PrivateKey, req.Permit, req.ToAddress)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to submit permit transfer",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Permit transfer sent successfully",
+		Data:    resp,
+	})
+}
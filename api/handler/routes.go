@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/gin-gonic/gin"
+)
+
+// route 單一 API 路由，handle 為 BlockchainHandler 的方法
+type route struct {
+	method string
+	path   string
+	handle func(*BlockchainHandler, *gin.Context)
+}
+
+// baseRoutes 所有區塊鏈皆註冊的路由
+var baseRoutes = []route{
+	{http.MethodPost, "/connect", (*BlockchainHandler).Connect},
+	{http.MethodGet, "/capabilities", (*BlockchainHandler).GetCapabilities},
+}
+
+// capabilityRoutes 各功能對應的路由
+var capabilityRoutes = map[types.Capability][]route{
+	types.CapabilityWallet: {
+		{http.MethodPost, "/wallet/generate", (*BlockchainHandler).GenerateWallet},
+		{http.MethodPost, "/address/validate", (*BlockchainHandler).ValidateAddress},
+	},
+	types.CapabilityNativeTransfer: {
+		{http.MethodPost, "/balance", (*BlockchainHandler).GetBalance},
+		{http.MethodPost, "/transfer/native", (*BlockchainHandler).SendNativeToken},
+	},
+	types.CapabilityTokens: {
+		{http.MethodPost, "/token/balance", (*BlockchainHandler).GetTokenBalance},
+		{http.MethodPost, "/token/transfer", (*BlockchainHandler).SendToken},
+	},
+	types.CapabilityContractDeploy: {
+		{http.MethodPost, "/contract/deploy", (*BlockchainHandler).DeployContract},
+	},
+	types.CapabilityContractCall: {
+		{http.MethodPost, "/contract/call", (*BlockchainHandler).CallContract},
+	},
+	types.CapabilityBlocks: {
+		{http.MethodPost, "/block", (*BlockchainHandler).GetBlock},
+		{http.MethodPost, "/block/height", (*BlockchainHandler).GetBlockHeight},
+		{http.MethodPost, "/tx", (*BlockchainHandler).GetTransaction},
+		{http.MethodPost, "/tx/receipt", (*BlockchainHandler).GetTransactionReceipt},
+	},
+	types.CapabilityEvents: {
+		{http.MethodGet, "/stream/blocks", (*BlockchainHandler).StreamBlocks},
+		{http.MethodGet, "/stream/pending", (*BlockchainHandler).StreamPendingTransactions},
+	},
+	types.CapabilityMessageSigning: {
+		{http.MethodPost, "/message/sign", (*BlockchainHandler).SignMessage},
+		{http.MethodPost, "/message/verify", (*BlockchainHandler).VerifyMessage},
+	},
+	types.CapabilityPermit: {
+		{http.MethodPost, "/permit/sign", (*BlockchainHandler).SignPermit},
+		{http.MethodPost, "/permit/transfer", (*BlockchainHandler).PermitTransfer},
+	},
+	types.CapabilityGasFees: {
+		{http.MethodPost, "/fees", (*BlockchainHandler).GetGasFees},
+	},
+	types.CapabilityAddressConversion: {
+		{http.MethodPost, "/address/convert", (*BlockchainHandler).ConvertAddress},
+	},
+	types.CapabilityTRC10: {
+		{http.MethodPost, "/trc10/balance", (*BlockchainHandler).GetAssetBalance},
+		{http.MethodPost, "/trc10/transfer", (*BlockchainHandler).TransferAsset},
+		{http.MethodPost, "/trc10/info", (*BlockchainHandler).GetAssetInfo},
+	},
+	types.CapabilityResources: {
+		{http.MethodPost, "/resources", (*BlockchainHandler).GetAccountResources},
+		{http.MethodPost, "/fees/estimate", (*BlockchainHandler).EstimateTronFee},
+	},
+	types.CapabilityStaking: {
+		{http.MethodPost, "/stake/freeze", (*BlockchainHandler).FreezeBalanceV2},
+		{http.MethodPost, "/stake/unfreeze", (*BlockchainHandler).UnfreezeBalanceV2},
+		{http.MethodPost, "/stake/withdraw", (*BlockchainHandler).WithdrawExpireUnfreeze},
+		{http.MethodPost, "/stake/delegate", (*BlockchainHandler).DelegateResource},
+		{http.MethodPost, "/stake/undelegate", (*BlockchainHandler).UnDelegateResource},
+		{http.MethodPost, "/stake/info", (*BlockchainHandler).GetStakeInfo},
+		{http.MethodPost, "/stake/delegated", (*BlockchainHandler).GetDelegatedResources},
+	},
+	types.CapabilityVoting: {
+		{http.MethodPost, "/vote/witnesses", (*BlockchainHandler).ListWitnesses},
+		{http.MethodPost, "/vote", (*BlockchainHandler).VoteWitness},
+		{http.MethodPost, "/rewards", (*BlockchainHandler).GetReward},
+		{http.MethodPost, "/rewards/withdraw", (*BlockchainHandler).WithdrawReward},
+	},
+	types.CapabilityMultiSig: {
+		{http.MethodPost, "/permission/update", (*BlockchainHandler).UpdateAccountPermission},
+		{http.MethodPost, "/multisig/create", (*BlockchainHandler).CreateMultiSigTransaction},
+		{http.MethodPost, "/multisig/sign", (*BlockchainHandler).SignMultiSigTransaction},
+		{http.MethodPost, "/multisig/weight", (*BlockchainHandler).GetTransactionSignWeight},
+		{http.MethodPost, "/multisig/broadcast", (*BlockchainHandler).BroadcastMultiSigTransaction},
+	},
+	types.CapabilityOfflineSigning: {
+		{http.MethodPost, "/offline/refblock", (*BlockchainHandler).GetRefBlock},
+		{http.MethodPost, "/offline/build", (*BlockchainHandler).BuildOfflineTransaction},
+		{http.MethodPost, "/offline/sign", (*BlockchainHandler).SignOfflineTransaction},
+		{http.MethodPost, "/offline/broadcast", (*BlockchainHandler).BroadcastOfflineTransaction},
+	},
+	// 備註附帶於轉帳請求，沒有獨立路由
+	types.CapabilityMemo: nil,
+}
+
+// RegisterRoutes 在路由群組註冊所有路由
+// 各功能的路由一律註冊，client 未宣告的功能在解析請求前即回應 501 與 unsupported_capability，而非 404
+func RegisterRoutes(g gin.IRoutes, h *BlockchainHandler) {
+	for _, r := range baseRoutes {
+		handle := r.handle
+		g.Handle(r.method, r.path, func(c *gin.Context) { handle(h, c) })
+	}
+	for capability, routes := range capabilityRoutes {
+		for _, r := range routes {
+			handle := r.handle
+			g.Handle(r.method, r.path, func(c *gin.Context) {
+				if !h.supports(capability) {
+					notSupported(c, capability, "Capability not supported")
+					return
+				}
+				handle(h, c)
+			})
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/blockchain-sdk-go/client"
+	"github.com/gin-gonic/gin"
+)
+
+func TestRegisterRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := newTestHandler(t, client.Ethereum)
+	r := gin.New()
+	RegisterRoutes(r.Group("/eth"), h)
+
+	// 所有功能的路由皆註冊
+	want := len(baseRoutes)
+	for _, routes := range capabilityRoutes {
+		want += len(routes)
+	}
+	if got := len(r.Routes()); got != want {
+		t.Errorf("expected %d routes, got %d", want, got)
+	}
+
+	// 未宣告的功能在解析請求前回應 501，而非 404 或 400
+	for path, capability := range map[string]types.Capability{
+		"/eth/stake/freeze":    types.CapabilityStaking,
+		"/eth/trc10/transfer":  types.CapabilityTRC10,
+		"/eth/offline/build":   types.CapabilityOfflineSigning,
+		"/eth/address/convert": types.CapabilityAddressConversion,
+	} {
+		req, _ := http.NewRequest("POST", path, strings.NewReader(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if got := unsupportedError(t, w).Capability; got != capability {
+			t.Errorf("%s: expected capability %q, got %q", path, capability, got)
+		}
+	}
+
+	// 已宣告的功能照常處理
+	req, _ := http.NewRequest("POST", "/eth/balance", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for invalid balance request, got %d", w.Code)
+	}
+	req, _ = http.NewRequest("GET", "/eth/capabilities", nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	var resp struct {
+		Data types.CapabilitiesResponse `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil || w.Code != http.StatusOK || !resp.Data.Capabilities.Has(types.CapabilityPermit) {
+		t.Errorf("unexpected capabilities response %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestRegisterRoutesForRegisteredChains(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for _, chain := range client.Chains() {
+		instances, err := chain.NewInstances()
+		if err != nil {
+			t.Fatalf("%s: %v", chain.Type, err)
+		}
+		for _, c := range chain.Capabilities {
+			if _, ok := capabilityRoutes[c]; !ok {
+				t.Errorf("%s: capability %q has no routes", chain.Type, c)
+			}
+		}
+		// 重複路由時 gin 會 panic
+		for _, instance := range instances {
+			RegisterRoutes(gin.New().Group("/"+instance.Name), NewInstanceHandler(instance))
+		}
+	}
+}
+
+func TestRouteSegmentsReserved(t *testing.T) {
+	// 網路名稱作為 /api/v1/{network} 前綴，不可與 /api/v1 下的靜態路由或各鏈路由同名
+	segments := []string{"networks", "chains"}
+	routes := append([]route{}, baseRoutes...)
+	for _, rs := range capabilityRoutes {
+		routes = append(routes, rs...)
+	}
+	for _, r := range routes {
+		segments = append(segments, strings.SplitN(strings.TrimPrefix(r.path, "/"), "/", 2)[0])
+	}
+	for _, s := range segments {
+		if !client.IsReservedNetworkName(s) {
+			t.Errorf("route segment %q is not reserved as a network name", s)
+		}
+	}
+}
@@ -0,0 +1,323 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/gin-gonic/gin"
+)
+
+// FreezeBalanceV2 質押 TRX 取得頻寬或能量
+// @Summary Stake TRX
+// @Description Freeze TRX with Stake 2.0 to obtain bandwidth or energy; the amount is in sun
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TronStakeRequest true "Stake details"
+// @Success 200 {object} types.Response{data=types.TransactionResponse}
+// @Router /tron/stake/freeze [post]
+func (h *BlockchainHandler) FreezeBalanceV2(c *gin.Context) {
+	var req types.TronStakeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	stakeManager, ok := h.stakeManager(c)
+	if !ok {
+		return
+	}
+
+	txHash, err := stakeManager.FreezeBalanceV2(c.Request.Context(), req.PrivateKey, req.Resource, req.Amount)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to freeze balance",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Balance frozen successfully",
+		Data:    types.TransactionResponse{TxHash: txHash},
+	})
+}
+
+// UnfreezeBalanceV2 解除質押
+// @Summary Unstake TRX
+// @Description Unfreeze staked TRX; the funds can be withdrawn after the unlock period
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TronStakeRequest true "Unstake details"
+// @Success 200 {object} types.Response{data=types.TransactionResponse}
+// @Router /tron/stake/unfreeze [post]
+func (h *BlockchainHandler) UnfreezeBalanceV2(c *gin.Context) {
+	var req types.TronStakeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	stakeManager, ok := h.stakeManager(c)
+	if !ok {
+		return
+	}
+
+	txHash, err := stakeManager.UnfreezeBalanceV2(c.Request.Context(), req.PrivateKey, req.Resource, req.Amount)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to unfreeze balance",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Balance unfrozen successfully",
+		Data:    types.TransactionResponse{TxHash: txHash},
+	})
+}
+
+// WithdrawExpireUnfreeze 提領已解鎖的 TRX
+// @Summary Withdraw unfrozen TRX
+// @Description Withdraw TRX whose unlock period has expired
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.WalletRequest true "Owner private key"
+// @Success 200 {object} types.Response{data=types.TransactionResponse}
+// @Router /tron/stake/withdraw [post]
+func (h *BlockchainHandler) WithdrawExpireUnfreeze(c *gin.Context) {
+	var req types.WalletRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	stakeManager, ok := h.stakeManager(c)
+	if !ok {
+		return
+	}
+
+	txHash, err := stakeManager.WithdrawExpireUnfreeze(c.Request.Context(), req.PrivateKey)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to withdraw unfrozen balance",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Unfrozen balance withdrawn successfully",
+		Data:    types.TransactionResponse{TxHash: txHash},
+	})
+}
+
+// DelegateResource 代理資源給其他地址
+// @Summary Delegate resources
+// @Description Delegate staked bandwidth or energy to another address, optionally locked for lock_period blocks
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TronDelegateRequest true "Delegation details"
+// @Success 200 {object} types.Response{data=types.TransactionResponse}
+// @Router /tron/stake/delegate [post]
+func (h *BlockchainHandler) DelegateResource(c *gin.Context) {
+	var req types.TronDelegateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if !h.validateAddresses(c, &req.ReceiverAddress) {
+		return
+	}
+
+	stakeManager, ok := h.stakeManager(c)
+	if !ok {
+		return
+	}
+
+	txHash, err := stakeManager.DelegateResource(c.Request.Context(), req.PrivateKey, req.ReceiverAddress, req.Resource, req.Amount, req.LockPeriod)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to delegate resource",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Resource delegated successfully",
+		Data:    types.TransactionResponse{TxHash: txHash},
+	})
+}
+
+// UnDelegateResource 收回代理的資源
+// @Summary Undelegate resources
+// @Description Reclaim bandwidth or energy delegated to another address
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TronDelegateRequest true "Delegation details"
+// @Success 200 {object} types.Response{data=types.TransactionResponse}
+// @Router /tron/stake/undelegate [post]
+func (h *BlockchainHandler) UnDelegateResource(c *gin.Context) {
+	var req types.TronDelegateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if !h.validateAddresses(c, &req.ReceiverAddress) {
+		return
+	}
+
+	stakeManager, ok := h.stakeManager(c)
+	if !ok {
+		return
+	}
+
+	txHash, err := stakeManager.UnDelegateResource(c.Request.Context(), req.PrivateKey, req.ReceiverAddress, req.Resource, req.Amount)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to undelegate resource",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Resource undelegated successfully",
+		Data:    types.TransactionResponse{TxHash: txHash},
+	})
+}
+
+// GetStakeInfo 查詢質押狀態
+// @Summary Get stake info
+// @Description Get staked, delegated, unfreezing, withdrawable and delegatable amounts of an address
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.BalanceRequest true "Account address"
+// @Success 200 {object} types.Response{data=types.TronStakeResponse}
+// @Router /tron/stake/info [post]
+func (h *BlockchainHandler) GetStakeInfo(c *gin.Context) {
+	var req types.BalanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if !h.validateAddresses(c, &req.Address) {
+		return
+	}
+
+	stakeManager, ok := h.stakeManager(c)
+	if !ok {
+		return
+	}
+
+	info, err := stakeManager.GetStakeInfo(c.Request.Context(), req.Address)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to get stake info",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Stake info retrieved successfully",
+		Data:    info,
+	})
+}
+
+// GetDelegatedResources 查詢代理給其他地址的資源
+// @Summary Get delegated resources
+// @Description List the resources an address has delegated to other addresses
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.BalanceRequest true "Account address"
+// @Success 200 {object} types.Response{data=[]types.TronDelegationResponse}
+// @Router /tron/stake/delegated [post]
+func (h *BlockchainHandler) GetDelegatedResources(c *gin.Context) {
+	var req types.BalanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if !h.validateAddresses(c, &req.Address) {
+		return
+	}
+
+	stakeManager, ok := h.stakeManager(c)
+	if !ok {
+		return
+	}
+
+	delegations, err := stakeManager.GetDelegatedResources(c.Request.Context(), req.Address)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to get delegated resources",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Delegated resources retrieved successfully",
+		Data:    delegations,
+	})
+}
+
+// stakeManager 取得 client 的質押操作，不支援時回應錯誤
+func (h *BlockchainHandler) stakeManager(c *gin.Context) (types.TronStakeManager, bool) {
+	return clientCapability[types.TronStakeManager](h, c, types.CapabilityStaking, "Staking not supported")
+}
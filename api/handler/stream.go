@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/gin-gonic/gin"
+)
+
+// streamHeartbeatInterval SSE 心跳間隔，避免代理伺服器因連線閒置而中斷
+const streamHeartbeatInterval = 15 * time.Second
+
+// StreamBlocks 以 SSE 推送新區塊
+// @Summary Stream new blocks
+// @Description Server-sent events feed of new block headers. Events are dropped for consumers that fall behind.
+// @Tags ethereum, tron
+// @Produce text/event-stream
+// @Success 200 {object} types.BlockHeaderResponse
+// @Router /eth/stream/blocks [get]
+// @Router /tron/stream/blocks [get]
+func (h *BlockchainHandler) StreamBlocks(c *gin.Context) {
+	subscriber, ok := clientCapability[types.BlockSubscriber](h, c, types.CapabilityEvents, "Block streaming not supported")
+	if !ok {
+		return
+	}
+
+	blocks, err := subscriber.SubscribeNewBlocks(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to subscribe to new blocks",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	streamEvents(c, "block", blocks)
+}
+
+// StreamPendingTransactions 以 SSE 推送待打包交易雜湊
+// @Summary Stream pending transactions
+// @Description Server-sent events feed of pending transaction hashes. Events are dropped for consumers that fall behind.
+// @Tags ethereum, tron
+// @Produce text/event-stream
+// @Success 200 {string} string "transaction hash"
+// @Router /eth/stream/pending [get]
+// @Router /tron/stream/pending [get]
+func (h *BlockchainHandler) StreamPendingTransactions(c *gin.Context) {
+	subscriber, ok := clientCapability[types.BlockSubscriber](h, c, types.CapabilityEvents, "Pending transaction streaming not supported")
+	if !ok {
+		return
+	}
+
+	hashes, err := subscriber.SubscribePendingTransactions(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to subscribe to pending transactions",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	streamEvents(c, "pending_tx", hashes)
+}
+
+// streamEvents 將訂閱 channel 的事件以 SSE 寫回客戶端，直到客戶端斷線或訂閱結束
+// 每個連線只阻塞自己的 goroutine，上游以非阻塞方式送出事件，慢速客戶端不會拖慢伺服器
+func streamEvents[T any](c *gin.Context, event string, events <-chan T) {
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // 關閉 Nginx 緩衝
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	c.Status(http.StatusOK)
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-heartbeat.C:
+			c.SSEvent("ping", time.Now().Unix())
+		case v, ok := <-events:
+			if !ok {
+				c.SSEvent("end", "subscription closed")
+				c.Writer.Flush()
+				return
+			}
+			c.SSEvent(event, v)
+		}
+		c.Writer.Flush()
+	}
+}
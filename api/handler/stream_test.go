@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/gin-gonic/gin"
+)
+
+// fakeSubscriber 測試用 client，送出固定區塊後關閉訂閱
+type fakeSubscriber struct {
+	blocks []types.BlockHeaderResponse
+}
+
+func (f *fakeSubscriber) Connect(ctx context.Context, url string) error { return nil }
+func (f *fakeSubscriber) Close() error                                  { return nil }
+func (f *fakeSubscriber) GetNativeBalance(ctx context.Context, address string) (*big.Float, error) {
+	return nil, nil
+}
+
+func (f *fakeSubscriber) SubscribeNewBlocks(ctx context.Context) (<-chan types.BlockHeaderResponse, error) {
+	ch := make(chan types.BlockHeaderResponse, len(f.blocks))
+	for _, b := range f.blocks {
+		ch <- b
+	}
+	close(ch)
+	return ch, nil
+}
+
+func (f *fakeSubscriber) SubscribePendingTransactions(ctx context.Context) (<-chan string, error) {
+	ch := make(chan string)
+	close(ch)
+	return ch, nil
+}
+
+func TestStreamBlocks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := &BlockchainHandler{client: &fakeSubscriber{blocks: []types.BlockHeaderResponse{
+		{Number: 1, Hash: "0x01"},
+		{Number: 2, Hash: "0x02"},
+	}}}
+	r := gin.New()
+	r.GET("/eth/stream/blocks", h.StreamBlocks)
+
+	req, _ := http.NewRequest("GET", "/eth/stream/blocks", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	body := w.Body.String()
+	if got := strings.Count(body, "event:block"); got != 2 {
+		t.Errorf("expected 2 block events, got %d: %s", got, body)
+	}
+	if !strings.Contains(body, "event:end") {
+		t.Errorf("expected end event, got: %s", body)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("unexpected content type %q", ct)
+	}
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"math/big"
+	"net/http"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/gin-gonic/gin"
+)
+
+// GetTokenBalance 查詢代幣餘額
+// @Summary Get token balance
+// @Description Get the ERC20/TRC20 balance of an address, scaled by the token decimals
+// @Tags ethereum, tron
+// @Accept json
+// @Produce json
+// @Param request body types.TokenBalanceRequest true "Token balance query details"
+// @Success 200 {object} types.Response{data=types.TokenBalanceResponse}
+// @Router /eth/token/balance [post]
+// @Router /tron/token/balance [post]
+func (h *BlockchainHandler) GetTokenBalance(c *gin.Context) {
+	var req types.TokenBalanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if !h.validateAddresses(c, &req.Address, &req.ContractAddress) {
+		return
+	}
+
+	tokenManager, ok := clientCapability[types.TokenContractManager](h, c, types.CapabilityTokens, "Token contract operations not supported")
+	if !ok {
+		return
+	}
+
+	balance, err := tokenManager.GetTokenBalance(c.Request.Context(), req.ContractAddress, req.Address)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to get token balance",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	balanceFloat, _ := balance.Float64()
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Token balance retrieved successfully",
+		Data: types.TokenBalanceResponse{
+			Address:         req.Address,
+			ContractAddress: req.ContractAddress,
+			Balance:         balanceFloat,
+		},
+	})
+}
+
+// SendToken 發送代幣
+// @Summary Send tokens
+// @Description Send ERC20/TRC20 tokens; the amount is scaled by the token decimals. Tron accepts an optional memo
+// @Tags ethereum, tron
+// @Accept json
+// @Produce json
+// @Param request body types.TokenTransferRequest true "Token transfer details"
+// @Success 200 {object} types.Response{data=types.TransactionResponse}
+// @Router /eth/token/transfer [post]
+// @Router /tron/token/transfer [post]
+func (h *BlockchainHandler) SendToken(c *gin.Context) {
+	var req types.TokenTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if !h.validateAddresses(c, &req.ToAddress, &req.ContractAddress) {
+		return
+	}
+
+	tokenManager, ok := clientCapability[types.TokenContractManager](h, c, types.CapabilityTokens, "Token contract operations not supported")
+	if !ok {
+		return
+	}
+
+	amount := new(big.Float).SetFloat64(req.Amount)
+	var txHash string
+	var err error
+	if req.Memo != "" {
+		memoTransferer, ok := h.memoTransferer(c)
+		if !ok {
+			return
+		}
+		txHash, err = memoTransferer.SendTokenWithMemo(c.Request.Context(), req.FromPrivateKey, req.ContractAddress, req.ToAddress, amount, req.Memo)
+	} else {
+		txHash, err = tokenManager.SendToken(c.Request.Context(), req.FromPrivateKey, req.ContractAddress, req.ToAddress, amount)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to send tokens",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Transaction sent successfully",
+		Data: types.TransactionResponse{
+			TxHash: txHash,
+		},
+	})
+}
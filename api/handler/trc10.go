@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"math/big"
+	"net/http"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/gin-gonic/gin"
+)
+
+// GetAssetBalance 查詢 TRC10 資產餘額
+// @Summary Get TRC10 balances
+// @Description Get TRC10 balances from the account assetV2 map, scaled by each asset precision; returns all held assets when asset_id is empty
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TRC10BalanceRequest true "TRC10 balance query details"
+// @Success 200 {object} types.Response{data=[]types.TRC10BalanceResponse}
+// @Router /tron/trc10/balance [post]
+func (h *BlockchainHandler) GetAssetBalance(c *gin.Context) {
+	var req types.TRC10BalanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if !h.validateAddresses(c, &req.Address) {
+		return
+	}
+
+	assetManager, ok := clientCapability[types.TRC10Manager](h, c, types.CapabilityTRC10, "TRC10 operations not supported")
+	if !ok {
+		return
+	}
+
+	var balances []types.TRC10BalanceResponse
+	var err error
+	if req.AssetID == "" {
+		balances, err = assetManager.GetAssetBalances(c.Request.Context(), req.Address)
+	} else {
+		var balance types.TRC10BalanceResponse
+		balance, err = assetManager.GetAssetBalance(c.Request.Context(), req.Address, req.AssetID)
+		balances = []types.TRC10BalanceResponse{balance}
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to get TRC10 balance",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "TRC10 balance retrieved successfully",
+		Data:    balances,
+	})
+}
+
+// TransferAsset 轉帳 TRC10 資產
+// @Summary Transfer TRC10 asset
+// @Description Transfer a TRC10 asset; the amount is scaled by the asset precision
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TRC10TransferRequest true "TRC10 transfer details"
+// @Success 200 {object} types.Response{data=types.TransactionResponse}
+// @Router /tron/trc10/transfer [post]
+func (h *BlockchainHandler) TransferAsset(c *gin.Context) {
+	var req types.TRC10TransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if !h.validateAddresses(c, &req.ToAddress) {
+		return
+	}
+
+	if req.Memo != "" {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Memo not supported for TRC10 transfers",
+		})
+		return
+	}
+
+	assetManager, ok := clientCapability[types.TRC10Manager](h, c, types.CapabilityTRC10, "TRC10 operations not supported")
+	if !ok {
+		return
+	}
+
+	amount := new(big.Float).SetFloat64(req.Amount)
+	txHash, err := assetManager.TransferAsset(c.Request.Context(), req.FromPrivateKey, req.AssetID, req.ToAddress, amount)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to transfer TRC10 asset",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "TRC10 asset transferred successfully",
+		Data:    types.TransactionResponse{TxHash: txHash},
+	})
+}
+
+// GetAssetInfo 查詢 TRC10 資產資訊
+// @Summary Get TRC10 asset info
+// @Description Get TRC10 asset details, including precision, by asset ID
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TRC10AssetRequest true "TRC10 asset ID"
+// @Success 200 {object} types.Response{data=types.TRC10AssetResponse}
+// @Router /tron/trc10/info [post]
+func (h *BlockchainHandler) GetAssetInfo(c *gin.Context) {
+	var req types.TRC10AssetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	assetManager, ok := clientCapability[types.TRC10Manager](h, c, types.CapabilityTRC10, "TRC10 operations not supported")
+	if !ok {
+		return
+	}
+
+	info, err := assetManager.GetAssetInfo(c.Request.Context(), req.AssetID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to get TRC10 asset info",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "TRC10 asset info retrieved successfully",
+		Data:    info,
+	})
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/gin-gonic/gin"
+)
+
+// GetAccountResources 查詢波場帳戶頻寬與能量
+// @Summary Get Tron account resources
+// @Description Get free and staked bandwidth, energy limit and usage of a Tron account
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.BalanceRequest true "Account address"
+// @Success 200 {object} types.Response{data=types.TronResourcesResponse}
+// @Router /tron/resources [post]
+func (h *BlockchainHandler) GetAccountResources(c *gin.Context) {
+	var req types.BalanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if !h.validateAddresses(c, &req.Address) {
+		return
+	}
+
+	resourceManager, ok := clientCapability[types.TronResourceManager](h, c, types.CapabilityResources, "Resource queries not supported")
+	if !ok {
+		return
+	}
+
+	resources, err := resourceManager.GetAccountResources(c.Request.Context(), req.Address)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to get account resources",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Account resources retrieved successfully",
+		Data:    resources,
+	})
+}
+
+// EstimateTronFee 預估波場交易手續費
+// @Summary Estimate Tron transaction fee
+// @Description Estimate the bandwidth and energy a TRX transfer, TRC20 transfer or contract call will consume and the TRX that will be burned
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TronFeeEstimateRequest true "Transaction to estimate"
+// @Success 200 {object} types.Response{data=types.TronFeeEstimateResponse}
+// @Router /tron/fees/estimate [post]
+func (h *BlockchainHandler) EstimateTronFee(c *gin.Context) {
+	var req types.TronFeeEstimateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	addrs := []*string{&req.FromAddress}
+	if req.ToAddress != "" {
+		addrs = append(addrs, &req.ToAddress)
+	}
+	if req.ContractAddress != "" {
+		addrs = append(addrs, &req.ContractAddress)
+	}
+	if !h.validateAddresses(c, addrs...) {
+		return
+	}
+
+	resourceManager, ok := clientCapability[types.TronResourceManager](h, c, types.CapabilityResources, "Fee estimation not supported")
+	if !ok {
+		return
+	}
+
+	estimate, err := resourceManager.EstimateFee(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to estimate fee",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Fee estimated successfully",
+		Data:    estimate,
+	})
+}
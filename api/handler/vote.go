@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/gin-gonic/gin"
+)
+
+// ListWitnesses 列出超級代表候選人
+// @Summary List Tron witnesses
+// @Description List Super Representative candidates ranked by vote count
+// @Tags tron
+// @Produce json
+// @Success 200 {object} types.Response{data=[]types.TronWitnessResponse}
+// @Router /tron/vote/witnesses [post]
+func (h *BlockchainHandler) ListWitnesses(c *gin.Context) {
+	voteManager, ok := h.voteManager(c)
+	if !ok {
+		return
+	}
+
+	witnesses, err := voteManager.ListWitnesses(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to list witnesses",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Witnesses retrieved successfully",
+		Data:    witnesses,
+	})
+}
+
+// VoteWitness 投票給超級代表候選人
+// @Summary Vote for Tron witnesses
+// @Description Cast votes for Super Representative candidates; replaces all previous votes of the account. One vote requires 1 TRX staked
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.TronVoteRequest true "Voter and votes"
+// @Success 200 {object} types.Response{data=types.TransactionResponse}
+// @Router /tron/vote [post]
+func (h *BlockchainHandler) VoteWitness(c *gin.Context) {
+	var req types.TronVoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	addrs := make([]*string, 0, len(req.Votes))
+	for i := range req.Votes {
+		addrs = append(addrs, &req.Votes[i].WitnessAddress)
+	}
+	if !h.validateAddresses(c, addrs...) {
+		return
+	}
+
+	voteManager, ok := h.voteManager(c)
+	if !ok {
+		return
+	}
+
+	txHash, err := voteManager.VoteWitness(c.Request.Context(), req.PrivateKey, req.Votes)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to vote",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Voted successfully",
+		Data:    types.TransactionResponse{TxHash: txHash},
+	})
+}
+
+// GetReward 查詢投票獎勵
+// @Summary Get Tron voting reward
+// @Description Get the withdrawable voting reward, voting power and current votes of an account; amounts are in sun
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.BalanceRequest true "Account address"
+// @Success 200 {object} types.Response{data=types.TronRewardResponse}
+// @Router /tron/rewards [post]
+func (h *BlockchainHandler) GetReward(c *gin.Context) {
+	var req types.BalanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	if !h.validateAddresses(c, &req.Address) {
+		return
+	}
+
+	voteManager, ok := h.voteManager(c)
+	if !ok {
+		return
+	}
+
+	reward, err := voteManager.GetReward(c.Request.Context(), req.Address)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to get reward",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Reward retrieved successfully",
+		Data:    reward,
+	})
+}
+
+// WithdrawReward 提領投票獎勵
+// @Summary Withdraw Tron voting reward
+// @Description Withdraw the accumulated voting reward to the account balance; allowed once every 24 hours
+// @Tags tron
+// @Accept json
+// @Produce json
+// @Param request body types.WalletRequest true "Owner private key"
+// @Success 200 {object} types.Response{data=types.TransactionResponse}
+// @Router /tron/rewards/withdraw [post]
+func (h *BlockchainHandler) WithdrawReward(c *gin.Context) {
+	var req types.WalletRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.Response{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid request",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	voteManager, ok := h.voteManager(c)
+	if !ok {
+		return
+	}
+
+	txHash, err := voteManager.WithdrawReward(c.Request.Context(), req.PrivateKey)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Failed to withdraw reward",
+			Data:    types.ErrorResponse{Error: err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.Response{
+		Code:    http.StatusOK,
+		Message: "Reward withdrawn successfully",
+		Data:    types.TransactionResponse{TxHash: txHash},
+	})
+}
+
+// voteManager 取得 client 的投票操作，不支援時回應錯誤
+func (h *BlockchainHandler) voteManager(c *gin.Context) (types.TronVoteManager, bool) {
+	return clientCapability[types.TronVoteManager](h, c, types.CapabilityVoting, "Voting not supported")
+}
@@ -13,6 +13,12 @@ type BlockchainClient interface {
 	Close() error
 }
 
+// ConnectionConfigurer 定義支援連線選項（TLS、API key、逾時）的客戶端
+type ConnectionConfigurer interface {
+	// ConnectWithOptions 依請求中的連線選項連接節點
+	ConnectWithOptions(ctx context.Context, req ConnectRequest) error
+}
+
 // WalletManager 定義錢包管理相關操作
 type WalletManager interface {
 	// GenerateNewWallet 生成新的錢包
@@ -29,8 +35,206 @@ type TokenManager interface {
 
 // ContractManager 定義智能合約相關操作
 type ContractManager interface {
-	// DeployContract 部署智能合約
-	DeployContract(ctx context.Context, privateKey, bytecode, abi string, constructorArgs []interface{}) (string, error)
+	// DeployContract 部署智能合約，回傳合約地址與部署交易雜湊
+	DeployContract(ctx context.Context, privateKey, bytecode, abi string, constructorArgs []interface{}, opts DeployOptions) (contractAddress string, txHash string, err error)
 	// CallContract 調用智能合約方法
 	CallContract(ctx context.Context, contractAddress, abi, method string, params []interface{}) (interface{}, error)
 }
+
+// BlockReader 定義區塊與交易查詢相關操作
+type BlockReader interface {
+	// GetBlockNumber 獲取目前區塊高度
+	GetBlockNumber(ctx context.Context) (uint64, error)
+	// GetBlock 依區塊高度、雜湊或 "latest" 查詢區塊
+	GetBlock(ctx context.Context, block string) (*BlockResponse, error)
+	// GetTransaction 依交易雜湊查詢交易
+	GetTransaction(ctx context.Context, txHash string) (*TransactionDetailResponse, error)
+	// GetTransactionReceipt 依交易雜湊查詢交易收據
+	GetTransactionReceipt(ctx context.Context, txHash string) (*ReceiptResponse, error)
+}
+
+// AddressValidator 定義地址驗證相關操作
+type AddressValidator interface {
+	// ValidateAddress 嚴格驗證地址，回傳正規化後的地址
+	ValidateAddress(address string) (normalized string, err error)
+}
+
+// NetworkInfoProvider 定義網路資訊查詢操作
+type NetworkInfoProvider interface {
+	// NetworkInfo 回傳 client 所連線網路的設定
+	NetworkInfo() NetworkResponse
+}
+
+// BlockSubscriber 定義新區塊與待打包交易的即時訂閱操作
+// 回傳的 channel 在 ctx 取消或上游訂閱中斷時關閉；接收端消化不及時事件會被丟棄
+type BlockSubscriber interface {
+	// SubscribeNewBlocks 訂閱新區塊
+	SubscribeNewBlocks(ctx context.Context) (<-chan BlockHeaderResponse, error)
+	// SubscribePendingTransactions 訂閱待打包交易雜湊
+	SubscribePendingTransactions(ctx context.Context) (<-chan string, error)
+}
+
+// MessageSigner 定義鏈下訊息簽名與驗證，EVM 使用 EIP-191（personal_sign），波場使用 TIP-191（signMessageV2）
+type MessageSigner interface {
+	// SignMessage 以私鑰簽署 UTF-8 訊息
+	SignMessage(ctx context.Context, privateKey, message string) (MessageSignatureResponse, error)
+	// VerifyMessage 由簽名還原簽名者地址
+	VerifyMessage(ctx context.Context, message, signature string) (string, error)
+}
+
+// PermitSigner 定義 EIP-2612 permit 免 gas 授權操作
+type PermitSigner interface {
+	// SignPermit 以持有人私鑰簽署 permit，value 為最小單位
+	SignPermit(ctx context.Context, ownerPrivateKey, contractAddress, spender string, value *big.Int, deadline uint64) (PermitSignatureResponse, error)
+	// PermitTransfer 由被授權地址提交 permit 後以 transferFrom 轉出代幣
+	PermitTransfer(ctx context.Context, spenderPrivateKey string, permit PermitSignatureResponse, toAddress string) (PermitTransferResponse, error)
+}
+
+// GasFeeOracle 定義手續費建議查詢操作
+type GasFeeOracle interface {
+	// GetGasFees 取得 slow、standard、fast 三檔手續費建議
+	GetGasFees(ctx context.Context) (GasFeesResponse, error)
+}
+
+// TokenContractManager 定義 ERC20/TRC20 代幣操作，金額依代幣 decimals 換算為一般單位
+type TokenContractManager interface {
+	// GetTokenBalance 查詢代幣餘額
+	GetTokenBalance(ctx context.Context, contractAddress, walletAddress string) (*big.Float, error)
+	// SendToken 發送代幣
+	SendToken(ctx context.Context, fromPrivateKey, contractAddress, toAddress string, amount *big.Float) (string, error)
+}
+
+// MemoTransferer 定義附帶備註的轉帳操作（波場備註寫入交易 raw_data.data）
+type MemoTransferer interface {
+	// SendNativeTokenWithMemo 發送附帶備註的主鏈幣
+	SendNativeTokenWithMemo(ctx context.Context, fromPrivateKey, toAddress string, amount *big.Float, memo string) (string, error)
+	// SendTokenWithMemo 發送附帶備註的代幣
+	SendTokenWithMemo(ctx context.Context, fromPrivateKey, contractAddress, toAddress string, amount *big.Float, memo string) (string, error)
+}
+
+// TronResourceManager 定義波場頻寬與能量資源操作
+type TronResourceManager interface {
+	// GetAccountResources 查詢帳戶的免費、質押頻寬與能量
+	GetAccountResources(ctx context.Context, address string) (TronResourcesResponse, error)
+	// EstimateFee 預估交易消耗的頻寬、能量與需燃燒的 TRX
+	EstimateFee(ctx context.Context, req TronFeeEstimateRequest) (TronFeeEstimateResponse, error)
+}
+
+// TronStakeManager 定義波場 Stake 2.0 質押與資源代理操作，金額皆為 sun
+// resource 為 bandwidth 或 energy
+type TronStakeManager interface {
+	// FreezeBalanceV2 質押 TRX 取得頻寬或能量
+	FreezeBalanceV2(ctx context.Context, privateKey, resource string, amount int64) (string, error)
+	// UnfreezeBalanceV2 解除質押，資金需等待解鎖期後提領
+	UnfreezeBalanceV2(ctx context.Context, privateKey, resource string, amount int64) (string, error)
+	// WithdrawExpireUnfreeze 提領已過解鎖期的 TRX
+	WithdrawExpireUnfreeze(ctx context.Context, privateKey string) (string, error)
+	// DelegateResource 將質押取得的資源代理給其他地址，lockPeriod 以區塊數計，0 為不鎖定
+	DelegateResource(ctx context.Context, privateKey, receiver, resource string, amount, lockPeriod int64) (string, error)
+	// UnDelegateResource 收回代理的資源
+	UnDelegateResource(ctx context.Context, privateKey, receiver, resource string, amount int64) (string, error)
+	// GetStakeInfo 查詢質押、解鎖中、可提領與可代理的金額
+	GetStakeInfo(ctx context.Context, address string) (TronStakeResponse, error)
+	// GetDelegatedResources 查詢地址代理給其他地址的資源
+	GetDelegatedResources(ctx context.Context, address string) ([]TronDelegationResponse, error)
+}
+
+// TronVoteManager 定義波場超級代表投票與投票獎勵提領
+type TronVoteManager interface {
+	// ListWitnesses 依得票數列出超級代表候選人
+	ListWitnesses(ctx context.Context) ([]TronWitnessResponse, error)
+	// VoteWitness 投票給候選人，取代帳戶先前的所有投票
+	VoteWitness(ctx context.Context, privateKey string, votes []TronVote) (string, error)
+	// GetReward 查詢可提領的投票獎勵與目前投票
+	GetReward(ctx context.Context, address string) (TronRewardResponse, error)
+	// WithdrawReward 提領投票獎勵（WithdrawBalance），每 24 小時可提領一次
+	WithdrawReward(ctx context.Context, privateKey string) (string, error)
+}
+
+// TRC10Manager 定義波場 TRC10 資產操作，金額依資產 precision 換算為一般單位
+type TRC10Manager interface {
+	// GetAssetBalances 查詢地址持有的所有 TRC10 資產
+	GetAssetBalances(ctx context.Context, address string) ([]TRC10BalanceResponse, error)
+	// GetAssetBalance 查詢地址持有的單一 TRC10 資產
+	GetAssetBalance(ctx context.Context, address, assetID string) (TRC10BalanceResponse, error)
+	// TransferAsset 轉帳 TRC10 資產
+	TransferAsset(ctx context.Context, fromPrivateKey, assetID, toAddress string, amount *big.Float) (string, error)
+	// GetAssetInfo 依資產 ID 查詢 TRC10 資產資訊
+	GetAssetInfo(ctx context.Context, assetID string) (TRC10AssetResponse, error)
+}
+
+// TronMultiSigManager 定義波場帳戶權限與多簽交易操作
+// 交易以十六進位編碼的 protobuf 在簽名者之間傳遞
+type TronMultiSigManager interface {
+	// UpdateAccountPermission 更新帳戶的 owner 與 active 權限
+	UpdateAccountPermission(ctx context.Context, privateKey string, owner TronPermission, actives []TronPermission) (string, error)
+	// CreateMultiSigTransaction 建立指定 permission_id 的未簽名交易
+	CreateMultiSigTransaction(ctx context.Context, req TronMultiSigCreateRequest) (TronMultiSigTransactionResponse, error)
+	// SignMultiSigTransaction 附加一個簽名並回傳目前的簽名權重
+	SignMultiSigTransaction(ctx context.Context, privateKey, transaction string) (TronMultiSigTransactionResponse, error)
+	// GetTransactionSignWeight 查詢交易目前的簽名權重
+	GetTransactionSignWeight(ctx context.Context, transaction string) (TronSignWeightResponse, error)
+	// BroadcastMultiSigTransaction 簽名權重達到門檻後廣播交易
+	BroadcastMultiSigTransaction(ctx context.Context, transaction string) (string, error)
+}
+
+// TronOfflineBuilder 定義波場離線交易建立、簽名與廣播，建立與簽名不需連線節點
+type TronOfflineBuilder interface {
+	// GetRefBlock 取得最新區塊作為 ref block 快照
+	GetRefBlock(ctx context.Context) (TronRefBlock, error)
+	// BuildOfflineTransaction 依 ref block 快照建立未簽名交易
+	BuildOfflineTransaction(req TronOfflineBuildRequest) (TronOfflineTransaction, error)
+	// SignOfflineTransaction 簽署可攜式交易
+	SignOfflineTransaction(privateKey string, tx TronOfflineTransaction) (TronOfflineTransaction, error)
+	// BroadcastOfflineTransaction 廣播已簽名的可攜式交易
+	BroadcastOfflineTransaction(ctx context.Context, tx TronOfflineTransaction) (string, error)
+}
+
+// TronAddressConverter 定義波場地址格式轉換操作
+type TronAddressConverter interface {
+	// ConvertAddress 將 base58、41 十六進位或 EVM 格式的地址轉為三種格式
+	ConvertAddress(address string) (TronAddressResponse, error)
+}
+
+// Capability 區塊鏈實作提供的功能，用於產生路由與回報可用功能
+type Capability string
+
+const (
+	CapabilityWallet            Capability = "wallet"             // 產生錢包與驗證地址
+	CapabilityNativeTransfer    Capability = "native_transfer"    // 主鏈幣餘額與轉帳
+	CapabilityTokens            Capability = "tokens"             // ERC20/TRC20 餘額與轉帳
+	CapabilityContractDeploy    Capability = "contract_deploy"    // 部署合約
+	CapabilityContractCall      Capability = "contract_call"      // 呼叫合約
+	CapabilityBlocks            Capability = "blocks"             // 區塊與交易查詢
+	CapabilityEvents            Capability = "events"             // 新區塊與待打包交易訂閱
+	CapabilityMessageSigning    Capability = "message_signing"    // 鏈下訊息簽名與驗證
+	CapabilityPermit            Capability = "permit"             // EIP-2612 permit
+	CapabilityGasFees           Capability = "gas_fees"           // EVM gas 費用建議
+	CapabilityAddressConversion Capability = "address_conversion" // 波場地址格式轉換
+	CapabilityTRC10             Capability = "trc10"              // TRC10 資產
+	CapabilityResources         Capability = "resources"          // 波場頻寬、能量與手續費預估
+	CapabilityStaking           Capability = "staking"            // 波場 Stake 2.0 質押與資源代理
+	CapabilityVoting            Capability = "voting"             // 波場超級代表投票與獎勵
+	CapabilityMultiSig          Capability = "multisig"           // 波場帳戶權限與多簽
+	CapabilityOfflineSigning    Capability = "offline_signing"    // 波場離線交易建立與簽名
+	CapabilityMemo              Capability = "memo"               // 轉帳附帶備註
+)
+
+// CapabilitySet client 宣告提供的功能集合
+type CapabilitySet []Capability
+
+// Has 檢查集合是否包含指定功能
+func (s CapabilitySet) Has(capability Capability) bool {
+	for _, c := range s {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
+
+// CapabilityProvider 定義 client 宣告的功能集合，handler 依此判斷功能是否可用
+type CapabilityProvider interface {
+	// Capabilities 回傳 client 提供的功能
+	Capabilities() CapabilitySet
+}
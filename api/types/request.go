@@ -2,8 +2,22 @@ package types
 
 // ConnectRequest 連接區塊鏈節點的請求結構
 // URL：節點連線位址
+// TLS、APIKey、Headers、DialTimeoutSeconds：連線選項，僅支援 ConnectionConfigurer 的 client 使用
 type ConnectRequest struct {
-	URL string `json:"url" binding:"required"` // 節點 URL
+	URL                string            `json:"url" binding:"required"`         // 節點 URL
+	TLS                *TLSConfig        `json:"tls,omitempty"`                  // TLS 設定
+	APIKey             string            `json:"api_key,omitempty"`              // 節點 API key，每次呼叫帶入
+	Headers            map[string]string `json:"headers,omitempty"`              // 每次呼叫附帶的 metadata/header
+	DialTimeoutSeconds int               `json:"dial_timeout_seconds,omitempty"` // 連線逾時秒數
+}
+
+// TLSConfig 節點連線 TLS 設定，憑證與私鑰為 PEM 字串
+type TLSConfig struct {
+	Enabled    bool   `json:"enabled"`               // gRPC 是否使用 TLS，https:// 一律使用
+	CACert     string `json:"ca_cert,omitempty"`     // 自訂 CA 憑證，未設定時使用系統 CA
+	ClientCert string `json:"client_cert,omitempty"` // 客戶端憑證（mTLS）
+	ClientKey  string `json:"client_key,omitempty"`  // 客戶端私鑰（mTLS）
+	ServerName string `json:"server_name,omitempty"` // 覆寫驗證憑證使用的主機名稱
 }
 
 // WalletRequest 錢包操作請求結構
@@ -16,10 +30,12 @@ type WalletRequest struct {
 // FromPrivateKey：發送方私鑰
 // ToAddress：接收方地址
 // Amount：轉帳金額
+// Memo：交易備註，僅支援 MemoTransferer 的 client（波場）使用
 type TransferRequest struct {
 	FromPrivateKey string  `json:"from_private_key" binding:"required"` // 發送方私鑰
 	ToAddress      string  `json:"to_address" binding:"required"`       // 接收方地址
 	Amount         float64 `json:"amount" binding:"required"`           // 轉帳金額
+	Memo           string  `json:"memo,omitempty"`                      // 交易備註
 }
 
 // TokenTransferRequest 代幣轉帳請求結構
@@ -34,11 +50,25 @@ type TokenTransferRequest struct {
 // Bytecode：合約 bytecode
 // ABI：合約 ABI
 // ConstructorArgs：建構子參數
+// DeployOptions：波場部署選項，以太坊忽略
 type ContractDeployRequest struct {
 	PrivateKey      string        `json:"private_key" binding:"required"` // 部署者私鑰
 	Bytecode        string        `json:"bytecode" binding:"required"`    // 合約 bytecode
 	ABI             string        `json:"abi" binding:"required"`         // 合約 ABI
 	ConstructorArgs []interface{} `json:"constructor_args"`               // 建構子參數
+	DeployOptions
+}
+
+// DeployOptions 合約部署選項，目前僅波場使用，零值使用預設值
+// Name：合約名稱
+// FeeLimit：部署可燃燒的 TRX 上限（sun）
+// OriginEnergyLimit：合約開發者每次呼叫最多提供的能量
+// ConsumeUserResourcePercent：呼叫者負擔的資源比例（0-100），未設定時為 100
+type DeployOptions struct {
+	Name                       string `json:"name,omitempty"`                                                            // 合約名稱
+	FeeLimit                   int64  `json:"fee_limit,omitempty"`                                                       // 手續費上限（sun）
+	OriginEnergyLimit          int64  `json:"origin_energy_limit,omitempty"`                                             // 開發者能量上限
+	ConsumeUserResourcePercent *int64 `json:"consume_user_resource_percent,omitempty" binding:"omitempty,min=0,max=100"` // 呼叫者資源比例
 }
 
 // ContractCallRequest 智能合約方法呼叫請求結構
@@ -65,3 +95,201 @@ type TokenBalanceRequest struct {
 	BalanceRequest
 	ContractAddress string `json:"contract_address" binding:"required"` // 代幣合約地址
 }
+
+// BlockRequest 查詢區塊請求結構
+// Block：區塊高度、區塊雜湊或 "latest"
+type BlockRequest struct {
+	Block string `json:"block" binding:"required"` // 區塊高度、雜湊或 latest
+}
+
+// TransactionQueryRequest 查詢交易請求結構
+// TxHash：交易雜湊
+type TransactionQueryRequest struct {
+	TxHash string `json:"tx_hash" binding:"required"` // 交易雜湊
+}
+
+// AddressValidateRequest 地址驗證請求結構
+// Address：待驗證地址
+type AddressValidateRequest struct {
+	Address string `json:"address" binding:"required"` // 待驗證地址
+}
+
+// MessageSignRequest 訊息簽名請求結構
+type MessageSignRequest struct {
+	PrivateKey string `json:"private_key" binding:"required"` // 簽名者私鑰
+	Message    string `json:"message" binding:"required"`     // 訊息（UTF-8）
+}
+
+// MessageVerifyRequest 訊息簽名驗證請求結構
+// Address：選填，提供時檢查簽名者是否為該地址
+type MessageVerifyRequest struct {
+	Message   string `json:"message" binding:"required"`   // 訊息（UTF-8）
+	Signature string `json:"signature" binding:"required"` // 十六進位簽名
+	Address   string `json:"address"`                      // 預期的簽名者地址
+}
+
+// PermitSignRequest EIP-2612 permit 簽名請求結構
+// PrivateKey：代幣持有人私鑰
+// ContractAddress：代幣合約地址
+// Spender：被授權地址
+// Value：授權金額（最小單位）
+// Deadline：簽名有效期限（Unix 秒）
+type PermitSignRequest struct {
+	PrivateKey      string `json:"private_key" binding:"required"`      // 持有人私鑰
+	ContractAddress string `json:"contract_address" binding:"required"` // 代幣合約地址
+	Spender         string `json:"spender" binding:"required"`          // 被授權地址
+	Value           string `json:"value" binding:"required"`            // 授權金額（最小單位）
+	Deadline        uint64 `json:"deadline" binding:"required"`         // 有效期限（Unix 秒）
+}
+
+// PermitTransferRequest 提交 permit 並 transferFrom 的請求結構
+// PrivateKey：被授權地址（relayer）私鑰，負擔手續費
+// Permit：持有人的 permit 簽名
+// ToAddress：代幣接收方地址
+type PermitTransferRequest struct {
+	PrivateKey string                  `json:"private_key" binding:"required"` // relayer 私鑰
+	Permit     PermitSignatureResponse `json:"permit" binding:"required"`      // permit 簽名
+	ToAddress  string                  `json:"to_address" binding:"required"`  // 接收方地址
+}
+
+// TronFeeEstimateRequest 波場手續費預估請求結構
+// ContractAddress 為空時預估 TRX 轉帳；有 ContractAddress 但未指定 Method 時預估 TRC20 transfer；
+// Amount 為最小單位的十進位整數字串（TRX 為 sun，TRC20 依代幣 decimals）；
+// 指定 Method 時依 ABI 與 Params 預估一般合約呼叫
+type TronFeeEstimateRequest struct {
+	FromAddress     string        `json:"from_address" binding:"required"` // 發送方地址
+	ToAddress       string        `json:"to_address"`                      // 轉帳接收方地址
+	Amount          string        `json:"amount"`                          // 轉帳金額（最小單位）
+	ContractAddress string        `json:"contract_address"`                // 合約地址
+	ABI             string        `json:"abi"`                             // 合約 ABI
+	Method          string        `json:"method"`                          // 合約方法名稱
+	Params          []interface{} `json:"params"`                          // 合約方法參數
+}
+
+// TronStakeRequest 波場質押與解除質押請求結構
+// Resource：bandwidth 或 energy
+// Amount：金額（sun）
+type TronStakeRequest struct {
+	PrivateKey string `json:"private_key" binding:"required"` // 質押者私鑰
+	Resource   string `json:"resource" binding:"required"`    // 資源類型
+	Amount     int64  `json:"amount" binding:"required"`      // 金額（sun）
+}
+
+// TronDelegateRequest 波場資源代理請求結構
+// LockPeriod：鎖定區塊數，僅代理時使用，0 為不鎖定
+type TronDelegateRequest struct {
+	TronStakeRequest
+	ReceiverAddress string `json:"receiver_address" binding:"required"` // 資源接收地址
+	LockPeriod      int64  `json:"lock_period"`                         // 鎖定區塊數
+}
+
+// TronVote 投給單一超級代表候選人的票數，1 票需 1 TRX 質押
+type TronVote struct {
+	WitnessAddress string `json:"witness_address" binding:"required"` // 候選人地址
+	Votes          int64  `json:"votes" binding:"required"`           // 票數
+}
+
+// TronVoteRequest 波場投票請求結構，每次投票會取代帳戶先前的所有投票
+type TronVoteRequest struct {
+	PrivateKey string     `json:"private_key" binding:"required"`      // 投票者私鑰
+	Votes      []TronVote `json:"votes" binding:"required,min=1,dive"` // 投票分配
+}
+
+// TRC10BalanceRequest 查詢 TRC10 資產餘額請求結構
+// AssetID：資產 ID，空值時回傳所有持有的資產
+type TRC10BalanceRequest struct {
+	BalanceRequest
+	AssetID string `json:"asset_id"` // 資產 ID
+}
+
+// TRC10TransferRequest TRC10 資產轉帳請求結構
+// AssetID：資產 ID
+type TRC10TransferRequest struct {
+	TransferRequest
+	AssetID string `json:"asset_id" binding:"required"` // 資產 ID
+}
+
+// TRC10AssetRequest 查詢 TRC10 資產資訊請求結構
+type TRC10AssetRequest struct {
+	AssetID string `json:"asset_id" binding:"required"` // 資產 ID
+}
+
+// TronPermissionKey 權限中的簽名地址與權重
+type TronPermissionKey struct {
+	Address string `json:"address" binding:"required"` // 簽名地址
+	Weight  int64  `json:"weight" binding:"required"`  // 權重
+}
+
+// TronPermission 帳戶權限設定
+// Operations：active 權限允許的合約類型名稱（如 TransferContract），空值時使用預設清單；owner 權限忽略
+type TronPermission struct {
+	Name       string              `json:"name"`                         // 權限名稱
+	Threshold  int64               `json:"threshold" binding:"required"` // 門檻權重
+	Keys       []TronPermissionKey `json:"keys" binding:"required"`      // 簽名地址
+	Operations []string            `json:"operations"`                   // 允許的合約類型
+}
+
+// TronPermissionUpdateRequest 波場帳戶權限更新請求結構
+type TronPermissionUpdateRequest struct {
+	PrivateKey string           `json:"private_key" binding:"required"` // 帳戶私鑰（需符合目前的 owner 權限）
+	Owner      TronPermission   `json:"owner" binding:"required"`       // owner 權限
+	Actives    []TronPermission `json:"actives" binding:"required"`     // active 權限
+}
+
+// TronMultiSigCreateRequest 波場多簽交易建立請求結構
+// ContractAddress 為空時為 TRX 轉帳，否則為 TRC20 轉帳
+// Amount：十進位整數字串，以最小單位計（TRX 為 sun，TRC20 依代幣 decimals）
+// PermissionID：0 為 owner，2 起為帳戶現有的 active 權限，witness 權限（1）不可使用
+// ExpirationSeconds：自 ref block 時間起算的有效時間，預設 1 小時，最長 24 小時
+type TronMultiSigCreateRequest struct {
+	OwnerAddress      string `json:"owner_address" binding:"required"` // 多簽帳戶地址
+	ToAddress         string `json:"to_address" binding:"required"`    // 接收方地址
+	Amount            string `json:"amount" binding:"required"`        // 轉帳金額（最小單位）
+	ContractAddress   string `json:"contract_address"`                 // TRC20 合約地址
+	PermissionID      int32  `json:"permission_id"`                    // 使用的權限 ID
+	ExpirationSeconds int64  `json:"expiration_seconds"`               // 交易有效時間（秒）
+}
+
+// TronMultiSigSignRequest 波場多簽交易簽名請求結構
+type TronMultiSigSignRequest struct {
+	PrivateKey  string `json:"private_key" binding:"required"` // 簽名者私鑰
+	Transaction string `json:"transaction" binding:"required"` // 十六進位編碼的交易
+}
+
+// TronMultiSigTransactionRequest 以十六進位編碼交易查詢權重或廣播的請求結構
+type TronMultiSigTransactionRequest struct {
+	Transaction string `json:"transaction" binding:"required"` // 十六進位編碼的交易
+}
+
+// TronRefBlock 波場 ref block 快照，離線建立交易時用於設定 ref_block_bytes 與 ref_block_hash
+// 交易須在 ref block 之後 65535 個區塊內上鏈
+type TronRefBlock struct {
+	Number    int64  `json:"number" binding:"required"`    // 區塊高度
+	Hash      string `json:"hash" binding:"required"`      // 區塊 ID（十六進位）
+	Timestamp int64  `json:"timestamp" binding:"required"` // 區塊時間（毫秒）
+}
+
+// TronOfflineBuildRequest 波場離線交易建立請求結構
+// ContractAddress 為空時為 TRX 轉帳，否則為 TRC20 transfer；離線無法查詢 decimals，Amount 一律為最小單位
+// ExpirationSeconds：自 ref block 時間起算的有效時間，預設 1 小時，最長 24 小時
+type TronOfflineBuildRequest struct {
+	RefBlock          TronRefBlock `json:"ref_block" binding:"required"`     // ref block 快照
+	OwnerAddress      string       `json:"owner_address" binding:"required"` // 發送方地址
+	ToAddress         string       `json:"to_address" binding:"required"`    // 接收方地址
+	Amount            string       `json:"amount" binding:"required"`        // 轉帳金額（最小單位）
+	ContractAddress   string       `json:"contract_address"`                 // TRC20 合約地址
+	FeeLimit          int64        `json:"fee_limit"`                        // TRC20 fee_limit（sun），預設使用 client 設定
+	Memo              string       `json:"memo"`                             // 交易備註
+	ExpirationSeconds int64        `json:"expiration_seconds"`               // 交易有效時間（秒）
+}
+
+// TronOfflineSignRequest 波場離線交易簽名請求結構
+type TronOfflineSignRequest struct {
+	PrivateKey  string                 `json:"private_key" binding:"required"` // 簽名者私鑰
+	Transaction TronOfflineTransaction `json:"transaction" binding:"required"` // 可攜式交易
+}
+
+// TronOfflineTransactionRequest 廣播可攜式交易的請求結構
+type TronOfflineTransactionRequest struct {
+	Transaction TronOfflineTransaction `json:"transaction" binding:"required"` // 可攜式交易
+}
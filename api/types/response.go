@@ -1,5 +1,7 @@
 package types
 
+import "encoding/json"
+
 // Response 標準 API 回應結構
 // Code：狀態碼
 // Message：訊息
@@ -42,8 +44,339 @@ type ContractResponse struct {
 	Result          interface{} `json:"result,omitempty"`  // 執行結果
 }
 
+// ErrCodeUnsupportedCapability client 未提供請求的功能
+const ErrCodeUnsupportedCapability = "unsupported_capability"
+
 // ErrorResponse 錯誤回應結構
 // Error：錯誤訊息
+// Code：錯誤代碼
+// Capability：未提供的功能，僅 unsupported_capability 時帶入
 type ErrorResponse struct {
-	Error string `json:"error"` // 錯誤訊息
+	Error      string     `json:"error"`                // 錯誤訊息
+	Code       string     `json:"code,omitempty"`       // 錯誤代碼
+	Capability Capability `json:"capability,omitempty"` // 未提供的功能
+}
+
+// BlockResponse 區塊查詢回應結構（以太坊與波場共用）
+// Number：區塊高度
+// Hash：區塊雜湊
+// ParentHash：父區塊雜湊
+// Timestamp：出塊時間（Unix 秒）
+// Producer：出塊者（礦工/驗證者/超級代表）
+// Transactions：區塊內交易雜湊
+type BlockResponse struct {
+	Number       uint64   `json:"number"`             // 區塊高度
+	Hash         string   `json:"hash"`               // 區塊雜湊
+	ParentHash   string   `json:"parent_hash"`        // 父區塊雜湊
+	Timestamp    int64    `json:"timestamp"`          // 出塊時間（Unix 秒）
+	Producer     string   `json:"producer,omitempty"` // 出塊者
+	TxCount      int      `json:"tx_count"`           // 交易數量
+	Transactions []string `json:"transactions"`       // 交易雜湊列表
+}
+
+// BlockHeightResponse 目前區塊高度回應結構
+// Number：最新區塊高度
+type BlockHeightResponse struct {
+	Number uint64 `json:"number"` // 最新區塊高度
+}
+
+// TransactionDetailResponse 交易詳情回應結構（以太坊與波場共用）
+// TxHash：交易雜湊
+// BlockNumber：所在區塊高度，待打包交易為 0
+// Pending：是否尚未上鏈
+// Type：交易類型（以太坊為交易類型編號，波場為合約類型名稱）
+// From/To：發送方與接收方地址
+// Value：轉帳金額（最小單位：wei/sun）
+// Input：呼叫資料（十六進位）
+type TransactionDetailResponse struct {
+	TxHash      string `json:"tx_hash"`                // 交易雜湊
+	BlockNumber uint64 `json:"block_number,omitempty"` // 區塊高度
+	BlockHash   string `json:"block_hash,omitempty"`   // 區塊雜湊
+	Pending     bool   `json:"pending"`                // 是否待打包
+	Type        string `json:"type"`                   // 交易類型
+	From        string `json:"from"`                   // 發送方地址
+	To          string `json:"to,omitempty"`           // 接收方地址
+	Value       string `json:"value"`                  // 金額（最小單位）
+	AssetID     string `json:"asset_id,omitempty"`     // TRC10 資產 ID（僅波場）
+	Nonce       uint64 `json:"nonce,omitempty"`        // 交易序號（僅以太坊）
+	Input       string `json:"input,omitempty"`        // 呼叫資料
+	Memo        string `json:"memo,omitempty"`         // 交易備註（僅波場 raw_data.data）
+}
+
+// LogResponse 交易事件日誌結構
+// Address：產生事件的合約地址
+// Topics：事件主題
+// Data：事件資料（十六進位）
+type LogResponse struct {
+	Address string   `json:"address"` // 合約地址
+	Topics  []string `json:"topics"`  // 事件主題
+	Data    string   `json:"data"`    // 事件資料
+}
+
+// ReceiptResponse 交易收據回應結構（以太坊與波場共用）
+// Success：交易是否執行成功
+// Fee：實際手續費（最小單位：wei/sun）
+// ResourceUsed：消耗資源（以太坊為 gas，波場為 energy）
+// ContractAddress：部署合約時產生的合約地址
+type ReceiptResponse struct {
+	TxHash          string        `json:"tx_hash"`                    // 交易雜湊
+	BlockNumber     uint64        `json:"block_number"`               // 區塊高度
+	BlockHash       string        `json:"block_hash,omitempty"`       // 區塊雜湊
+	Success         bool          `json:"success"`                    // 是否成功
+	Fee             string        `json:"fee"`                        // 手續費（最小單位）
+	ResourceUsed    uint64        `json:"resource_used"`              // 消耗資源
+	ContractAddress string        `json:"contract_address,omitempty"` // 合約地址
+	Logs            []LogResponse `json:"logs"`                       // 事件日誌
+}
+
+// AddressValidateResponse 地址驗證回應結構
+// Address：原始輸入地址
+// Valid：是否為合法地址
+// Normalized：正規化後的地址（以太坊為 EIP-55 校驗格式，波場為 base58）
+// Reason：驗證失敗原因
+type AddressValidateResponse struct {
+	Address    string `json:"address"`              // 原始輸入地址
+	Valid      bool   `json:"valid"`                // 是否合法
+	Normalized string `json:"normalized,omitempty"` // 正規化地址
+	Reason     string `json:"reason,omitempty"`     // 失敗原因
+}
+
+// NetworkResponse 網路資訊回應結構
+// Name：網路名稱（同時為路由前綴）
+// ChainID：鏈 ID
+// NativeSymbol：主鏈幣符號
+// FeeModel：手續費模型（legacy / eip1559）
+// ExplorerURL：區塊瀏覽器位址
+// Connected：是否已連線節點
+type NetworkResponse struct {
+	Name         string `json:"name"`                   // 網路名稱
+	ChainID      int64  `json:"chain_id,omitempty"`     // 鏈 ID
+	NativeSymbol string `json:"native_symbol"`          // 主鏈幣符號
+	FeeModel     string `json:"fee_model,omitempty"`    // 手續費模型
+	ExplorerURL  string `json:"explorer_url,omitempty"` // 區塊瀏覽器位址
+	Connected    bool   `json:"connected"`              // 是否已連線
+}
+
+// ChainResponse 已註冊區塊鏈實作的回應結構
+type ChainResponse struct {
+	Type         string        `json:"type"`         // 區塊鏈類型
+	Description  string        `json:"description"`  // 說明
+	Capabilities CapabilitySet `json:"capabilities"` // 提供的功能
+}
+
+// CapabilitiesResponse 區塊鏈 client 提供的功能
+// Network：網路名稱
+// Connected：是否已連線節點
+// Capabilities：提供的功能
+type CapabilitiesResponse struct {
+	Network      string        `json:"network,omitempty"` // 網路名稱
+	Connected    bool          `json:"connected"`         // 是否已連線
+	Capabilities CapabilitySet `json:"capabilities"`      // 提供的功能
+}
+
+// BlockHeaderResponse 新區塊事件結構
+// Number：區塊高度
+// Hash：區塊雜湊
+// ParentHash：父區塊雜湊
+// Timestamp：出塊時間（Unix 秒）
+type BlockHeaderResponse struct {
+	Number     uint64 `json:"number"`      // 區塊高度
+	Hash       string `json:"hash"`        // 區塊雜湊
+	ParentHash string `json:"parent_hash"` // 父區塊雜湊
+	Timestamp  int64  `json:"timestamp"`   // 出塊時間（Unix 秒）
+}
+
+// MessageSignatureResponse 訊息簽名回應結構
+type MessageSignatureResponse struct {
+	Address   string `json:"address"`   // 簽名者地址
+	Signature string `json:"signature"` // 0x 開頭的 65 bytes 簽名（r、s、v，v 為 27 或 28）
+}
+
+// MessageVerifyResponse 訊息簽名驗證回應結構
+type MessageVerifyResponse struct {
+	Address string `json:"address"` // 還原的簽名者地址
+	Valid   bool   `json:"valid"`   // 簽名者是否與請求的地址相符，未提供地址時恆為 true
+}
+
+// PermitSignatureResponse EIP-2612 permit 簽名回應結構
+type PermitSignatureResponse struct {
+	ContractAddress string `json:"contract_address"` // 代幣合約地址
+	Owner           string `json:"owner"`            // 持有人地址
+	Spender         string `json:"spender"`          // 被授權地址
+	Value           string `json:"value"`            // 授權金額（最小單位）
+	Nonce           string `json:"nonce"`            // 持有人 permit nonce
+	Deadline        uint64 `json:"deadline"`         // 有效期限（Unix 秒）
+	V               uint8  `json:"v"`                // 簽名 v（27 或 28）
+	R               string `json:"r"`                // 簽名 r（0x 十六進位）
+	S               string `json:"s"`                // 簽名 s（0x 十六進位）
+}
+
+// PermitTransferResponse permit 與 transferFrom 交易回應結構
+type PermitTransferResponse struct {
+	PermitTxHash   string `json:"permit_tx_hash"`   // permit 交易雜湊
+	TransferTxHash string `json:"transfer_tx_hash"` // transferFrom 交易雜湊
+}
+
+// GasFeeTierResponse 單一檔位的手續費建議，金額皆為 wei
+type GasFeeTierResponse struct {
+	Tier                 string `json:"tier"`                               // 檔位：slow、standard、fast
+	MaxPriorityFeePerGas string `json:"max_priority_fee_per_gas,omitempty"` // EIP-1559 小費上限
+	MaxFeePerGas         string `json:"max_fee_per_gas,omitempty"`          // EIP-1559 手續費上限
+	GasPrice             string `json:"gas_price,omitempty"`                // legacy gasPrice
+	EstimatedSeconds     uint64 `json:"estimated_seconds"`                  // 預估打包時間（秒）
+}
+
+// GasFeesResponse 手續費預言機回應結構
+type GasFeesResponse struct {
+	BlockNumber uint64               `json:"block_number"`       // 取樣的最新區塊
+	FeeModel    string               `json:"fee_model"`          // 手續費模型
+	BaseFee     string               `json:"base_fee,omitempty"` // 下一區塊 base fee（wei）
+	BlockTime   float64              `json:"block_time"`         // 平均出塊時間（秒）
+	Tiers       []GasFeeTierResponse `json:"tiers"`              // 各檔位建議
+}
+
+// TokenBalanceResponse 代幣餘額回應結構
+type TokenBalanceResponse struct {
+	Address         string  `json:"address"`          // 查詢地址
+	ContractAddress string  `json:"contract_address"` // 代幣合約地址
+	Balance         float64 `json:"balance"`          // 餘額（依 decimals 換算）
+}
+
+// TronResourcesResponse 波場帳戶資源回應結構
+type TronResourcesResponse struct {
+	Address              string `json:"address"`                // 查詢地址
+	FreeBandwidthLimit   int64  `json:"free_bandwidth_limit"`   // 每日免費頻寬
+	FreeBandwidthUsed    int64  `json:"free_bandwidth_used"`    // 已使用免費頻寬
+	StakedBandwidthLimit int64  `json:"staked_bandwidth_limit"` // 質押取得的頻寬
+	StakedBandwidthUsed  int64  `json:"staked_bandwidth_used"`  // 已使用質押頻寬
+	EnergyLimit          int64  `json:"energy_limit"`           // 質押取得的能量
+	EnergyUsed           int64  `json:"energy_used"`            // 已使用能量
+	AvailableBandwidth   int64  `json:"available_bandwidth"`    // 剩餘頻寬（免費與質押合計）
+	AvailableEnergy      int64  `json:"available_energy"`       // 剩餘能量
+}
+
+// TronFeeEstimateResponse 波場手續費預估回應結構，金額皆為 sun
+type TronFeeEstimateResponse struct {
+	Bandwidth        int64   `json:"bandwidth"`          // 預估消耗頻寬（bytes）
+	Energy           int64   `json:"energy"`             // 預估消耗能量
+	NewAccount       bool    `json:"new_account"`        // 接收方尚未啟用，需支付啟用費
+	BandwidthBurnSun int64   `json:"bandwidth_burn_sun"` // 頻寬不足需燃燒的 TRX
+	EnergyBurnSun    int64   `json:"energy_burn_sun"`    // 能量不足需燃燒的 TRX
+	ActivationFeeSun int64   `json:"activation_fee_sun"` // 帳戶啟用費
+	TotalBurnSun     int64   `json:"total_burn_sun"`     // 合計燃燒
+	TotalBurnTRX     float64 `json:"total_burn_trx"`     // 合計燃燒（TRX）
+}
+
+// TronUnfreezeResponse 解鎖中的質押
+type TronUnfreezeResponse struct {
+	Resource   string `json:"resource"`    // 資源類型
+	Amount     int64  `json:"amount"`      // 金額（sun）
+	ExpireTime int64  `json:"expire_time"` // 可提領時間（毫秒）
+}
+
+// TronStakeResponse 波場 Stake 2.0 質押狀態回應結構，金額皆為 sun
+type TronStakeResponse struct {
+	Address                string                 `json:"address"`                  // 查詢地址
+	FrozenBandwidth        int64                  `json:"frozen_bandwidth"`         // 質押取得頻寬的 TRX（不含已代理）
+	FrozenEnergy           int64                  `json:"frozen_energy"`            // 質押取得能量的 TRX（不含已代理）
+	DelegatedBandwidth     int64                  `json:"delegated_bandwidth"`      // 代理給他人的頻寬質押
+	DelegatedEnergy        int64                  `json:"delegated_energy"`         // 代理給他人的能量質押
+	AcquiredBandwidth      int64                  `json:"acquired_bandwidth"`       // 他人代理的頻寬質押
+	AcquiredEnergy         int64                  `json:"acquired_energy"`          // 他人代理的能量質押
+	DelegatableBandwidth   int64                  `json:"delegatable_bandwidth"`    // 目前可代理的頻寬質押
+	DelegatableEnergy      int64                  `json:"delegatable_energy"`       // 目前可代理的能量質押
+	Unfreezing             []TronUnfreezeResponse `json:"unfreezing"`               // 解鎖中的質押
+	WithdrawableAmount     int64                  `json:"withdrawable_amount"`      // 已過解鎖期可提領的金額
+	AvailableUnfreezeCount int64                  `json:"available_unfreeze_count"` // 剩餘可解除質押次數
+}
+
+// TronDelegationResponse 代理給單一地址的資源，金額皆為 sun
+type TronDelegationResponse struct {
+	ReceiverAddress     string `json:"receiver_address"`      // 資源接收地址
+	BandwidthAmount     int64  `json:"bandwidth_amount"`      // 代理的頻寬質押
+	EnergyAmount        int64  `json:"energy_amount"`         // 代理的能量質押
+	BandwidthExpireTime int64  `json:"bandwidth_expire_time"` // 頻寬鎖定到期時間（毫秒），0 為未鎖定
+	EnergyExpireTime    int64  `json:"energy_expire_time"`    // 能量鎖定到期時間（毫秒），0 為未鎖定
+}
+
+// TronWitnessResponse 超級代表候選人回應結構
+type TronWitnessResponse struct {
+	Rank           int    `json:"rank"`             // 依得票數排名，前 27 名為超級代表
+	Address        string `json:"address"`          // 候選人地址
+	URL            string `json:"url"`              // 候選人網址
+	VoteCount      int64  `json:"vote_count"`       // 得票數
+	TotalProduced  int64  `json:"total_produced"`   // 已產出區塊數
+	TotalMissed    int64  `json:"total_missed"`     // 漏出區塊數
+	LatestBlockNum int64  `json:"latest_block_num"` // 最近產出的區塊高度
+	IsActive       bool   `json:"is_active"`        // 是否為本輪出塊的超級代表
+}
+
+// TronRewardResponse 投票獎勵與投票狀態回應結構，金額皆為 sun
+type TronRewardResponse struct {
+	Address            string     `json:"address"`              // 查詢地址
+	Reward             int64      `json:"reward"`               // 可提領的投票獎勵
+	VotingPower        int64      `json:"voting_power"`         // 質押取得的總票數
+	UsedVotes          int64      `json:"used_votes"`           // 已投出的票數
+	Votes              []TronVote `json:"votes"`                // 目前的投票分配
+	LatestWithdrawTime int64      `json:"latest_withdraw_time"` // 上次提領時間（毫秒），每 24 小時可提領一次
+}
+
+// TRC10BalanceResponse TRC10 資產餘額回應結構
+type TRC10BalanceResponse struct {
+	AssetID    string  `json:"asset_id"`    // 資產 ID
+	Name       string  `json:"name"`        // 資產名稱
+	Abbr       string  `json:"abbr"`        // 資產簡稱
+	Precision  int32   `json:"precision"`   // 小數位數
+	Balance    float64 `json:"balance"`     // 餘額（依 precision 換算）
+	RawBalance string  `json:"raw_balance"` // 餘額（最小單位）
+}
+
+// TRC10AssetResponse TRC10 資產資訊回應結構
+type TRC10AssetResponse struct {
+	AssetID      string `json:"asset_id"`      // 資產 ID
+	Name         string `json:"name"`          // 資產名稱
+	Abbr         string `json:"abbr"`          // 資產簡稱
+	Precision    int32  `json:"precision"`     // 小數位數
+	TotalSupply  string `json:"total_supply"`  // 總發行量（最小單位）
+	OwnerAddress string `json:"owner_address"` // 發行地址
+	Description  string `json:"description"`   // 描述
+	URL          string `json:"url"`           // 官方網址
+}
+
+// TronSignWeightResponse 多簽交易簽名權重回應結構
+type TronSignWeightResponse struct {
+	PermissionID      int32    `json:"permission_id"`      // 交易使用的權限 ID
+	PermissionName    string   `json:"permission_name"`    // 權限名稱
+	Threshold         int64    `json:"threshold"`          // 門檻權重
+	CurrentWeight     int64    `json:"current_weight"`     // 目前簽名權重
+	ApprovedAddresses []string `json:"approved_addresses"` // 已簽名地址
+	Enough            bool     `json:"enough"`             // 是否已達門檻
+	Result            string   `json:"result"`             // 節點檢查結果
+	Message           string   `json:"message,omitempty"`  // 節點檢查訊息
+}
+
+// TronMultiSigTransactionResponse 多簽交易回應結構
+type TronMultiSigTransactionResponse struct {
+	TxID         string                  `json:"tx_id"`                 // 交易 ID
+	Transaction  string                  `json:"transaction"`           // 十六進位編碼的交易，傳給下一位簽名者
+	PermissionID int32                   `json:"permission_id"`         // 使用的權限 ID
+	Expiration   int64                   `json:"expiration"`            // 交易過期時間（毫秒）
+	SignWeight   *TronSignWeightResponse `json:"sign_weight,omitempty"` // 目前簽名權重
+}
+
+// TronOfflineTransaction 可攜式波場交易，格式與 TronGrid /wallet API 的交易 JSON 相同
+// RawDataHex 為簽名與廣播的依據，RawData 僅供檢視，兩者不一致時拒絕處理
+type TronOfflineTransaction struct {
+	TxID       string          `json:"txID"`                                    // 交易 ID
+	RawData    json.RawMessage `json:"raw_data,omitempty" swaggertype:"object"` // 交易內容（可讀 JSON）
+	RawDataHex string          `json:"raw_data_hex" binding:"required"`         // 十六進位 protobuf 交易內容
+	Signature  []string        `json:"signature,omitempty"`                     // 十六進位簽名
+	Visible    bool            `json:"visible"`                                 // 地址是否為 base58，固定為 false
+}
+
+// TronAddressResponse 波場地址格式轉換回應結構
+type TronAddressResponse struct {
+	Base58 string `json:"base58"` // base58check 格式（T 開頭）
+	Hex    string `json:"hex"`    // 0x41 前綴的 21 bytes 十六進位
+	EVM    string `json:"evm"`    // 去除 0x41 前綴的 20 bytes EIP-55 格式，用於 ABI 參數
 }
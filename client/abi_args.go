@@ -0,0 +1,171 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// addressParser 將 API 傳入的地址字串轉為 ABI address 值，各鏈提供自己的地址格式
+type addressParser func(string) (common.Address, error)
+
+// convertABIArgs 將 JSON 解析出的參數轉為 go-ethereum ABI 打包所需的 Go 型別
+// 整數可為數字、十進位字串或 0x 十六進位字串；bytes 為 0x 十六進位字串；陣列為 JSON 陣列
+func convertABIArgs(args abi.Arguments, values []interface{}, parseAddr addressParser) ([]interface{}, error) {
+	if len(values) != len(args) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(args), len(values))
+	}
+	out := make([]interface{}, len(values))
+	for i, arg := range args {
+		v, err := convertABIValue(arg.Type, values[i], parseAddr)
+		if err != nil {
+			name := arg.Name
+			if name == "" {
+				name = strconv.Itoa(i)
+			}
+			return nil, fmt.Errorf("argument %s (%s): %w", name, arg.Type.String(), err)
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+// convertABIValue 依 ABI 型別轉換單一參數
+func convertABIValue(t abi.Type, v interface{}, parseAddr addressParser) (interface{}, error) {
+	switch t.T {
+	case abi.AddressTy:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected address string, got %T", v)
+		}
+		return parseAddr(s)
+	case abi.BoolTy:
+		switch b := v.(type) {
+		case bool:
+			return b, nil
+		case string:
+			return strconv.ParseBool(b)
+		}
+		return nil, fmt.Errorf("expected bool, got %T", v)
+	case abi.StringTy:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", v)
+		}
+		return s, nil
+	case abi.IntTy, abi.UintTy:
+		n, err := toBigInt(v)
+		if err != nil {
+			return nil, err
+		}
+		return sizedInt(t, n)
+	case abi.BytesTy:
+		return decodeHexArg(v)
+	case abi.FixedBytesTy:
+		b, err := decodeHexArg(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > t.Size {
+			return nil, fmt.Errorf("expected at most %d bytes, got %d", t.Size, len(b))
+		}
+		arr := reflect.New(t.GetType()).Elem()
+		reflect.Copy(arr, reflect.ValueOf(b))
+		return arr.Interface(), nil
+	case abi.SliceTy, abi.ArrayTy:
+		items, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected array, got %T", v)
+		}
+		if t.T == abi.ArrayTy && len(items) != t.Size {
+			return nil, fmt.Errorf("expected %d elements, got %d", t.Size, len(items))
+		}
+		var out reflect.Value
+		if t.T == abi.ArrayTy {
+			out = reflect.New(t.GetType()).Elem()
+		} else {
+			out = reflect.MakeSlice(t.GetType(), len(items), len(items))
+		}
+		for i, item := range items {
+			elem, err := convertABIValue(*t.Elem, item, parseAddr)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			out.Index(i).Set(reflect.ValueOf(elem))
+		}
+		return out.Interface(), nil
+	}
+	return nil, fmt.Errorf("unsupported ABI type %s", t.String())
+}
+
+// toBigInt 轉換 JSON 數字或字串為整數
+func toBigInt(v interface{}) (*big.Int, error) {
+	switch n := v.(type) {
+	case float64:
+		f := big.NewFloat(n)
+		if !f.IsInt() {
+			return nil, fmt.Errorf("%v is not an integer", n)
+		}
+		i, _ := f.Int(nil)
+		return i, nil
+	case json.Number:
+		return toBigInt(n.String())
+	case string:
+		s := strings.TrimSpace(n)
+		base := 10
+		if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+			s, base = s[2:], 16
+		}
+		i, ok := new(big.Int).SetString(s, base)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %q", n)
+		}
+		return i, nil
+	}
+	return nil, fmt.Errorf("expected integer, got %T", v)
+}
+
+// sizedInt 依位元數回傳 ABI 需要的整數型別，超過 64 位元使用 *big.Int
+func sizedInt(t abi.Type, n *big.Int) (interface{}, error) {
+	if t.T == abi.UintTy && n.Sign() < 0 {
+		return nil, fmt.Errorf("negative value %s for unsigned type", n)
+	}
+	bits := n.BitLen()
+	if t.T == abi.IntTy && n.Sign() < 0 {
+		bits = new(big.Int).Add(n, big.NewInt(1)).BitLen()
+	}
+	limit := t.Size
+	if t.T == abi.IntTy {
+		limit--
+	}
+	if bits > limit {
+		return nil, fmt.Errorf("value %s overflows %s", n, t.String())
+	}
+	typ := t.GetType()
+	if typ.Kind() == reflect.Ptr {
+		return n, nil
+	}
+	if t.T == abi.UintTy {
+		return reflect.ValueOf(n.Uint64()).Convert(typ).Interface(), nil
+	}
+	return reflect.ValueOf(n.Int64()).Convert(typ).Interface(), nil
+}
+
+// decodeHexArg 解碼 0x 十六進位字串
+func decodeHexArg(v interface{}) ([]byte, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected hex string, got %T", v)
+	}
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		s = "0x" + s
+	}
+	return hexutil.Decode(s)
+}
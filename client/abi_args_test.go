@@ -0,0 +1,70 @@
+package client
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const testArgsABI = `[{"type":"constructor","inputs":[
+  {"name":"owner","type":"address"},
+  {"name":"supply","type":"uint256"},
+  {"name":"decimals","type":"uint8"},
+  {"name":"delta","type":"int64"},
+  {"name":"name","type":"string"},
+  {"name":"enabled","type":"bool"},
+  {"name":"salt","type":"bytes32"},
+  {"name":"holders","type":"address[]"}
+]}]`
+
+func TestConvertABIArgs(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(testArgsABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := []interface{}{
+		"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+		"1000000000000000000000000",
+		float64(6),
+		"-5",
+		"Token",
+		true,
+		"0x01",
+		[]interface{}{"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"},
+	}
+	args, err := convertABIArgs(parsed.Constructor.Inputs, values, parseTronABIAddress)
+	if err != nil {
+		t.Fatalf("convertABIArgs failed: %v", err)
+	}
+	if _, err := parsed.Pack("", args...); err != nil {
+		t.Fatalf("pack failed: %v", err)
+	}
+	owner := common.HexToAddress("0xa614f803B6FD780986A42c78Ec9c7f77e6DeD13C")
+	if args[0].(common.Address) != owner {
+		t.Errorf("unexpected owner %v", args[0])
+	}
+	if supply, _ := new(big.Int).SetString("1000000000000000000000000", 10); args[1].(*big.Int).Cmp(supply) != 0 {
+		t.Errorf("unexpected supply %v", args[1])
+	}
+	if args[2].(uint8) != 6 || args[3].(int64) != -5 {
+		t.Errorf("unexpected sized ints %v %v", args[2], args[3])
+	}
+	if salt := args[6].([32]byte); salt[0] != 0x01 {
+		t.Errorf("unexpected salt %x", salt)
+	}
+}
+
+func TestConvertABIArgs_Errors(t *testing.T) {
+	parsed, _ := abi.JSON(strings.NewReader(`[{"type":"constructor","inputs":[{"name":"v","type":"uint8"}]}]`))
+	for _, v := range []interface{}{float64(256), float64(-1), float64(1.5), "abc"} {
+		if _, err := convertABIArgs(parsed.Constructor.Inputs, []interface{}{v}, parseEthereumAddress); err == nil {
+			t.Errorf("expected error for %v", v)
+		}
+	}
+	if _, err := convertABIArgs(parsed.Constructor.Inputs, nil, parseEthereumAddress); err == nil {
+		t.Error("expected error for missing argument")
+	}
+}
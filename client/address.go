@@ -0,0 +1,90 @@
+package client
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	troncommon "github.com/fbsobreira/gotron-sdk/pkg/common"
+)
+
+// ValidateEthereumAddress 嚴格驗證以太坊地址並回傳 EIP-55 校驗格式
+// 地址須為 0x 開頭的 40 位十六進位字串；大小寫混合時須符合 EIP-55 校驗
+func ValidateEthereumAddress(addr string) (string, error) {
+	parsed, err := parseEthereumAddress(addr)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Hex(), nil
+}
+
+// parseEthereumAddress 嚴格解析以太坊地址，取代會默默截斷或補零的 common.HexToAddress
+func parseEthereumAddress(addr string) (common.Address, error) {
+	if !strings.HasPrefix(addr, "0x") && !strings.HasPrefix(addr, "0X") {
+		return common.Address{}, fmt.Errorf("%w: %q must start with 0x", ErrInvalidAddress, addr)
+	}
+	body := addr[2:]
+	if len(body) != 2*common.AddressLength || !isHex(body) {
+		return common.Address{}, fmt.Errorf("%w: %q must be 20 bytes of hex", ErrInvalidAddress, addr)
+	}
+	parsed := common.HexToAddress(body)
+	// 全小寫或全大寫不帶校驗資訊，大小寫混合時才檢查 EIP-55
+	if body != strings.ToLower(body) && body != strings.ToUpper(body) && parsed.Hex()[2:] != body {
+		return common.Address{}, fmt.Errorf("%w: %q", ErrInvalidChecksum, addr)
+	}
+	return parsed, nil
+}
+
+// ValidateTronAddress 嚴格驗證波場地址並回傳 base58 格式
+// 接受 base58（T 開頭，需通過 base58check 校驗）、0x41 前綴的十六進位與 0x 開頭的 20 bytes EVM 格式
+func ValidateTronAddress(addr string) (string, error) {
+	parsed, err := parseTronAddress(addr)
+	if err != nil {
+		return "", err
+	}
+	return parsed.String(), nil
+}
+
+// ConvertTronAddress 將任一格式的波場地址轉為 base58、41 十六進位與 EVM 三種格式
+func ConvertTronAddress(addr string) (types.TronAddressResponse, error) {
+	parsed, err := parseTronAddress(addr)
+	if err != nil {
+		return types.TronAddressResponse{}, err
+	}
+	return types.TronAddressResponse{
+		Base58: parsed.String(),
+		Hex:    hex.EncodeToString(parsed.Bytes()),
+		EVM:    tronToEVMAddress(parsed).Hex(),
+	}, nil
+}
+
+// parseTronAddress 嚴格解析波場地址，依長度判斷格式：
+// 34 字元為 base58；41 開頭的 21 bytes 十六進位（可帶 0x）為波場 hex；0x 開頭的 20 bytes 為 EVM 格式
+func parseTronAddress(addr string) (address.Address, error) {
+	body := strings.TrimPrefix(strings.TrimPrefix(addr, "0x"), "0X")
+	switch {
+	case len(addr) == address.AddressLengthBase58:
+		// DecodeCheck 會同時檢查長度、0x41 前綴與雙重 SHA-256 校驗碼
+		decoded, err := troncommon.DecodeCheck(addr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidAddress, addr, err)
+		}
+		return address.Address(decoded), nil
+	case len(body) == 2*address.AddressLength:
+		if !isHex(body) || !strings.HasPrefix(body, "41") {
+			return nil, fmt.Errorf("%w: %q must be 21 bytes of hex starting with 41", ErrInvalidAddress, addr)
+		}
+		decoded, _ := hex.DecodeString(body)
+		return address.Address(decoded), nil
+	case len(body) == 2*common.AddressLength && len(body) != len(addr):
+		evm, err := parseEthereumAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		return address.Address(append([]byte{address.TronBytePrefix}, evm.Bytes()...)), nil
+	}
+	return nil, fmt.Errorf("%w: %q must be a base58, 41-prefixed hex or 0x EVM address", ErrInvalidAddress, addr)
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateEthereumAddress(t *testing.T) {
+	checksummed := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	cases := []struct {
+		in      string
+		want    string
+		wantErr error
+	}{
+		{checksummed, checksummed, nil},
+		{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", checksummed, nil},
+		{"0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", checksummed, nil},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", "", ErrInvalidChecksum},
+		{"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "", ErrInvalidAddress},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", "", ErrInvalidAddress},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAzz", "", ErrInvalidAddress},
+	}
+	for _, tc := range cases {
+		got, err := ValidateEthereumAddress(tc.in)
+		if !errors.Is(err, tc.wantErr) {
+			t.Errorf("ValidateEthereumAddress(%q) error = %v, want %v", tc.in, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("ValidateEthereumAddress(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestValidateTronAddress(t *testing.T) {
+	valid := "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+	got, err := ValidateTronAddress(valid)
+	if err != nil || got != valid {
+		t.Errorf("ValidateTronAddress(%q) = %q, %v", valid, got, err)
+	}
+	// 末字元改動導致校驗碼錯誤
+	for _, in := range []string{"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6", "0x42a614f803b6fd780986a42c78ec9c7f77e6ded13c", "a614f803b6fd780986a42c78ec9c7f77e6ded13c"} {
+		if _, err := ValidateTronAddress(in); !errors.Is(err, ErrInvalidAddress) {
+			t.Errorf("ValidateTronAddress(%q) error = %v, want ErrInvalidAddress", in, err)
+		}
+	}
+}
+
+func TestConvertTronAddress(t *testing.T) {
+	want := "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+	forms, err := ConvertTronAddress(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forms.Base58 != want || forms.Hex != "41a614f803b6fd780986a42c78ec9c7f77e6ded13c" || forms.EVM != "0xa614f803B6FD780986A42c78Ec9c7f77e6DeD13C" {
+		t.Fatalf("unexpected forms %+v", forms)
+	}
+	for _, in := range []string{forms.Hex, "0x" + forms.Hex, forms.EVM, strings.ToLower(forms.EVM)} {
+		got, err := ValidateTronAddress(in)
+		if err != nil || got != want {
+			t.Errorf("ValidateTronAddress(%q) = %q, %v", in, got, err)
+		}
+	}
+	// 大小寫混合的 EVM 格式須符合 EIP-55
+	if _, err := ValidateTronAddress("0xA614f803B6FD780986A42c78Ec9c7f77e6DeD13C"); !errors.Is(err, ErrInvalidChecksum) {
+		t.Errorf("expected ErrInvalidChecksum, got %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrInvalidBlockID 區塊識別格式錯誤
+var ErrInvalidBlockID = errors.New("invalid block id: expected number, hash or \"latest\"")
+
+// ErrInvalidTxHash 交易雜湊格式錯誤
+var ErrInvalidTxHash = errors.New("invalid transaction hash: expected 32 bytes hex")
+
+// blockID 區塊識別，latest、高度與雜湊三擇一
+type blockID struct {
+	latest bool
+	number uint64
+	hash   string // 不含 0x 前綴的 64 位十六進位字串
+}
+
+// parseBlockID 解析區塊識別字串
+// 支援 "latest"、十進位區塊高度與 32 bytes 十六進位雜湊（可帶 0x 前綴）
+func parseBlockID(s string) (blockID, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.EqualFold(s, "latest") {
+		return blockID{latest: true}, nil
+	}
+	if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return blockID{number: n}, nil
+	}
+	h := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if len(h) != 64 || !isHex(h) {
+		return blockID{}, ErrInvalidBlockID
+	}
+	return blockID{hash: strings.ToLower(h)}, nil
+}
+
+// ValidateTxHash 檢查交易雜湊是否為 32 bytes 十六進位字串（可帶 0x 前綴），EVM 與波場共用
+func ValidateTxHash(s string) error {
+	h := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if len(h) != 64 || !isHex(h) {
+		return ErrInvalidTxHash
+	}
+	return nil
+}
+
+// parseTxHash 解析 EVM 交易雜湊，不足或超過 32 bytes 時回傳 ErrInvalidTxHash，不做補零或截斷
+func parseTxHash(s string) (common.Hash, error) {
+	if err := ValidateTxHash(s); err != nil {
+		return common.Hash{}, err
+	}
+	return common.HexToHash(s), nil
+}
+
+// isHex 檢查字串是否全為十六進位字元
+func isHex(s string) bool {
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9', c >= 'a' && c <= 'f', c >= 'A' && c <= 'F':
+		default:
+			return false
+		}
+	}
+	return true
+}
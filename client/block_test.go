@@ -0,0 +1,27 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestParseBlockID(t *testing.T) {
+	id, err := parseBlockID("latest")
+	if err != nil || !id.latest {
+		t.Errorf("parseBlockID(latest) = %+v, %v", id, err)
+	}
+	id, err = parseBlockID("12345")
+	if err != nil || id.number != 12345 {
+		t.Errorf("parseBlockID(12345) = %+v, %v", id, err)
+	}
+	hash := "0xABCDEF0000000000000000000000000000000000000000000000000000000001"
+	id, err = parseBlockID(hash)
+	if err != nil || id.hash != "abcdef0000000000000000000000000000000000000000000000000000000001" {
+		t.Errorf("parseBlockID(hash) = %+v, %v", id, err)
+	}
+	if _, err = parseBlockID("0x1234"); err == nil {
+		t.Error("expected error for short hash, got nil")
+	}
+	if _, err = parseBlockID("pending"); err == nil {
+		t.Error("expected error for unknown tag, got nil")
+	}
+}
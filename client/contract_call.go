@@ -0,0 +1,121 @@
+package client
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// addressFormatter 將 ABI address 回傳值格式化為各鏈的地址字串
+type addressFormatter func(common.Address) string
+
+// packContractCall 解析 ABI 並打包方法呼叫資料
+func packContractCall(abiJSON, method string, params []interface{}, parseAddr addressParser) (abi.ABI, []byte, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return abi.ABI{}, nil, err
+	}
+	m, ok := parsedABI.Methods[method]
+	if !ok {
+		return abi.ABI{}, nil, fmt.Errorf("method %q not found in ABI", method)
+	}
+	args, err := convertABIArgs(m.Inputs, params, parseAddr)
+	if err != nil {
+		return abi.ABI{}, nil, err
+	}
+	data, err := parsedABI.Pack(method, args...)
+	if err != nil {
+		return abi.ABI{}, nil, err
+	}
+	return parsedABI, data, nil
+}
+
+// decodeContractOutput 依 ABI 解碼方法回傳值，兩條鏈共用以維持相同的 API 回應
+// 單一回傳值直接回傳；多個回傳值組成 map，鍵為輸出名稱（無名稱時為序號）
+// 整數轉為十進位字串，bytes 轉為 0x 十六進位，地址依鏈格式化
+func decodeContractOutput(parsedABI abi.ABI, method string, output []byte, formatAddr addressFormatter) (interface{}, error) {
+	m, ok := parsedABI.Methods[method]
+	if !ok {
+		return nil, fmt.Errorf("method %q not found in ABI", method)
+	}
+	if len(m.Outputs) == 0 {
+		return nil, nil
+	}
+	if len(output) == 0 {
+		return nil, fmt.Errorf("contract returned no data for %s", method)
+	}
+	values, err := m.Outputs.Unpack(output)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s result: %w", method, err)
+	}
+	if len(values) == 1 {
+		return normalizeABIValue(reflect.ValueOf(values[0]), formatAddr), nil
+	}
+	result := make(map[string]interface{}, len(values))
+	for i, v := range values {
+		name := m.Outputs[i].Name
+		if name == "" {
+			name = fmt.Sprint(i)
+		}
+		result[name] = normalizeABIValue(reflect.ValueOf(v), formatAddr)
+	}
+	return result, nil
+}
+
+// normalizeABIValue 將解碼結果轉為適合 JSON 輸出的值
+func normalizeABIValue(v reflect.Value, formatAddr addressFormatter) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	switch x := v.Interface().(type) {
+	case common.Address:
+		return formatAddr(x)
+	case *big.Int:
+		return x.String()
+	case []byte:
+		return hexutil.Encode(x)
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprint(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprint(v.Uint())
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return hexutil.Encode(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = normalizeABIValue(v.Index(i), formatAddr)
+		}
+		return items
+	case reflect.Struct:
+		fields := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Type().Field(i); f.IsExported() {
+				fields[f.Name] = normalizeABIValue(v.Field(i), formatAddr)
+			}
+		}
+		return fields
+	}
+	return v.Interface()
+}
+
+// formatEthereumAddress 以 EIP-55 格式輸出地址
+func formatEthereumAddress(addr common.Address) string {
+	return addr.Hex()
+}
+
+// formatTronAddress 以 base58 格式輸出地址
+func formatTronAddress(addr common.Address) string {
+	return tronAddressFromEVM(addr.Bytes())
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const testCallABI = `[
+  {"type":"function","name":"balanceOf","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+  {"type":"function","name":"info","inputs":[],"outputs":[{"name":"owner","type":"address"},{"name":"decimals","type":"uint8"},{"name":"","type":"bytes32"},{"name":"holders","type":"address[]"}]}
+]`
+
+func TestPackContractCall_TronAddress(t *testing.T) {
+	_, data, err := packContractCall(testCallABI, "balanceOf", []interface{}{"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"}, parseTronABIAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "70a08231000000000000000000000000a614f803b6fd780986a42c78ec9c7f77e6ded13c"
+	if got := common.Bytes2Hex(data); got != want {
+		t.Errorf("unexpected call data %s", got)
+	}
+	if _, _, err := packContractCall(testCallABI, "missing", nil, parseTronABIAddress); err == nil {
+		t.Error("expected error for unknown method")
+	}
+}
+
+func TestDecodeContractOutput(t *testing.T) {
+	parsed, _ := abi.JSON(strings.NewReader(testCallABI))
+	owner := common.HexToAddress("0xa614f803B6FD780986A42c78Ec9c7f77e6DeD13C")
+
+	out, _ := parsed.Methods["balanceOf"].Outputs.Pack(big.NewInt(42))
+	if got, err := decodeContractOutput(parsed, "balanceOf", out, formatTronAddress); err != nil || got != "42" {
+		t.Errorf("unexpected single result %v %v", got, err)
+	}
+
+	out, _ = parsed.Methods["info"].Outputs.Pack(owner, uint8(6), [32]byte{0xab}, []common.Address{owner})
+	got, err := decodeContractOutput(parsed, "info", out, formatTronAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"owner":    "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+		"decimals": "6",
+		"2":        "0xab00000000000000000000000000000000000000000000000000000000000000",
+		"holders":  []interface{}{"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected tuple result %#v", got)
+	}
+
+	if _, err := decodeContractOutput(parsed, "balanceOf", nil, formatEthereumAddress); err == nil {
+		t.Error("expected error for empty output")
+	}
+}
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ScanCursor 區塊掃描進度
+// Block：最後完成掃描的區塊高度
+// Hash：該區塊雜湊
+// Recent：最近追蹤的主鏈區塊（依高度遞增），重啟時還原區塊追蹤以偵測停機期間的鏈重組
+// Deposits：Recent 區塊中已送出的入帳，停機期間被回滾時據以送出 Removed
+type ScanCursor struct {
+	Block    uint64     `json:"block"`              // 區塊高度
+	Hash     string     `json:"hash"`               // 區塊雜湊
+	Recent   []BlockRef `json:"recent,omitempty"`   // 最近追蹤的主鏈區塊
+	Deposits []Deposit  `json:"deposits,omitempty"` // 最近區塊中的入帳
+}
+
+// CursorStore 掃描進度儲存介面，依鏈名稱保存
+type CursorStore interface {
+	// Load 讀取進度，尚無紀錄時 ok 為 false
+	Load(chain string) (cursor ScanCursor, ok bool, err error)
+	// Save 保存進度
+	Save(chain string, cursor ScanCursor) error
+}
+
+// MemoryCursorStore 記憶體進度儲存，重啟後遺失，適用於測試或不需續掃的情境
+type MemoryCursorStore struct {
+	mu      sync.Mutex
+	cursors map[string]ScanCursor
+}
+
+// NewMemoryCursorStore 建立記憶體進度儲存
+func NewMemoryCursorStore() *MemoryCursorStore {
+	return &MemoryCursorStore{cursors: make(map[string]ScanCursor)}
+}
+
+// Load 實作 CursorStore 介面
+func (m *MemoryCursorStore) Load(chain string) (ScanCursor, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.cursors[chain]
+	return c, ok, nil
+}
+
+// Save 實作 CursorStore 介面
+func (m *MemoryCursorStore) Save(chain string, cursor ScanCursor) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cursors[chain] = cursor
+	return nil
+}
+
+// FileCursorStore 以 JSON 檔保存各鏈掃描進度，寫入時先寫暫存檔再改名，避免中途當機損毀檔案
+type FileCursorStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileCursorStore 建立檔案進度儲存
+func NewFileCursorStore(path string) *FileCursorStore {
+	return &FileCursorStore{path: path}
+}
+
+// Load 實作 CursorStore 介面
+func (f *FileCursorStore) Load(chain string) (ScanCursor, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	cursors, err := f.read()
+	if err != nil {
+		return ScanCursor{}, false, err
+	}
+	c, ok := cursors[chain]
+	return c, ok, nil
+}
+
+// Save 實作 CursorStore 介面
+func (f *FileCursorStore) Save(chain string, cursor ScanCursor) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	cursors, err := f.read()
+	if err != nil {
+		return err
+	}
+	cursors[chain] = cursor
+	data, err := json.MarshalIndent(cursors, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path)
+}
+
+// read 讀取所有進度，檔案不存在時回傳空集合
+func (f *FileCursorStore) read() (map[string]ScanCursor, error) {
+	cursors := make(map[string]ScanCursor)
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cursors, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &cursors); err != nil {
+		return nil, fmt.Errorf("parse cursor file %s: %w", f.path, err)
+	}
+	return cursors, nil
+}
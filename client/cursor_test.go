@@ -0,0 +1,31 @@
+package client
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestFileCursorStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cursor.json")
+	store := NewFileCursorStore(path)
+	if _, ok, err := store.Load("eth"); err != nil || ok {
+		t.Fatalf("expected empty store, got ok=%v err=%v", ok, err)
+	}
+	if err := store.Save("eth", ScanCursor{Block: 100, Hash: "0xabc"}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if err := store.Save("tron", ScanCursor{Block: 7, Hash: "def"}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	// 以新實例讀取，模擬重啟
+	reloaded := NewFileCursorStore(path)
+	c, ok, err := reloaded.Load("eth")
+	if err != nil || !ok || c.Block != 100 || c.Hash != "0xabc" {
+		t.Errorf("unexpected eth cursor %+v ok=%v err=%v", c, ok, err)
+	}
+	c, ok, _ = reloaded.Load("tron")
+	if !ok || c.Block != 7 {
+		t.Errorf("unexpected tron cursor %+v", c)
+	}
+}
@@ -0,0 +1,451 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// transferEventSig ERC20/TRC20 Transfer(address,address,uint256) 事件簽名
+var transferEventSig = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// Deposit 入帳事件
+// 事件以 (TxHash, Token, LogIndex) 唯一識別；同一筆入帳會隨確認數增加重複送出，消費端須冪等處理
+type Deposit struct {
+	Chain         string `json:"chain"`           // 鏈名稱
+	TxHash        string `json:"tx_hash"`         // 交易雜湊
+	LogIndex      uint   `json:"log_index"`       // 代幣轉帳的事件序號，主鏈幣為 0
+	BlockNumber   uint64 `json:"block_number"`    // 區塊高度
+	BlockHash     string `json:"block_hash"`      // 區塊雜湊
+	From          string `json:"from"`            // 發送方地址
+	To            string `json:"to"`              // 入帳地址（監控地址）
+	Token         string `json:"token,omitempty"` // 代幣合約地址，TRC10 為資產 ID，主鏈幣為空
+	Amount        string `json:"amount"`          // 金額（最小單位）
+	Memo          string `json:"memo,omitempty"`  // 交易備註（波場 raw_data.data）
+	Confirmations uint64 `json:"confirmations"`   // 目前確認數
+	Confirmed     bool   `json:"confirmed"`       // 是否已達所需確認數
+	Removed       bool   `json:"removed"`         // 所在區塊因鏈重組被回滾，先前送出的事件應作廢
+}
+
+// key 入帳事件的唯一識別
+func (d Deposit) key() string {
+	return fmt.Sprintf("%s/%s/%d", d.TxHash, d.Token, d.LogIndex)
+}
+
+// depositSource 由各鏈 client 實作的入帳掃描能力
+type depositSource interface {
+	blockRefSource
+	GetBlockNumber(ctx context.Context) (uint64, error)
+	// scanDepositBlock 掃描單一區塊中轉入監控地址的主鏈幣與代幣轉帳，代幣只接受 tokens 中的合約或資產
+	scanDepositBlock(ctx context.Context, number uint64, watched, tokens *watchSet) (BlockRef, []Deposit, error)
+}
+
+// DepositScannerConfig 入帳掃描設定
+// Chain：進度儲存的鍵，預設為 client 的網路名稱
+// Confirmations：視為入帳完成所需確認數，預設 1
+// PollInterval：輪詢新區塊的間隔，預設 3 秒
+// StartBlock：尚無進度時的起始高度，0 表示從最新區塊開始
+// Tokens：接受入帳的代幣白名單，ERC20/TRC20 為合約地址，TRC10 為資產 ID；為空時只偵測主鏈幣。
+// 任何人都能部署合約發出 Transfer 事件，不在白名單中的代幣轉帳一律忽略
+// ReorgWindow：追蹤最近區塊雜湊以偵測鏈重組的視窗大小，預設為 64 與 2 倍確認數的較大者
+// Cursor：進度儲存，預設為記憶體儲存
+// OnError：輪詢發生錯誤時的回呼，錯誤發生後會於下次輪詢重試
+type DepositScannerConfig struct {
+	Chain         string
+	Confirmations uint64
+	PollInterval  time.Duration
+	StartBlock    uint64
+	Tokens        []string
+	ReorgWindow   int
+	Cursor        CursorStore
+	OnError       func(error)
+}
+
+// DepositScanner 入帳掃描器，跟隨新區塊偵測轉入監控地址的主鏈幣與 ERC20/TRC20 轉帳
+type DepositScanner struct {
+	source    depositSource
+	validator types.AddressValidator
+	cfg       DepositScannerConfig
+	watched   *watchSet
+	tokens    *watchSet
+	events    chan Deposit
+
+	tracker *BlockTracker        // 主鏈區塊追蹤，用於偵測鏈重組
+	next    uint64               // 下一個待掃描區塊
+	pending map[string]Deposit   // 尚未達確認數的入帳
+	byBlock map[uint64][]Deposit // 追蹤視窗內各區塊的入帳，鏈重組時據以作廢
+}
+
+// NewDepositScanner 建立入帳掃描器，client 須為已連線的 EthereumClient 或 TronClient
+func NewDepositScanner(c types.BlockchainClient, cfg DepositScannerConfig) (*DepositScanner, error) {
+	source, ok := c.(depositSource)
+	if !ok {
+		return nil, ErrUnsupportedBlockchain
+	}
+	validator, ok := c.(types.AddressValidator)
+	if !ok {
+		return nil, ErrUnsupportedBlockchain
+	}
+	if cfg.Chain == "" {
+		if p, ok := c.(types.NetworkInfoProvider); ok {
+			cfg.Chain = p.NetworkInfo().Name
+		}
+	}
+	if cfg.Chain == "" {
+		return nil, errors.New("deposit scanner: chain name is required")
+	}
+	if cfg.Confirmations == 0 {
+		cfg.Confirmations = 1
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 3 * time.Second
+	}
+	if cfg.ReorgWindow <= 0 {
+		cfg.ReorgWindow = 64
+		if w := int(2 * cfg.Confirmations); w > cfg.ReorgWindow {
+			cfg.ReorgWindow = w
+		}
+	}
+	if cfg.Cursor == nil {
+		cfg.Cursor = NewMemoryCursorStore()
+	}
+	tokens := newWatchSet()
+	for _, token := range cfg.Tokens {
+		normalized, err := normalizeDepositToken(validator, token)
+		if err != nil {
+			return nil, err
+		}
+		tokens.add(normalized)
+	}
+	return &DepositScanner{
+		source:    source,
+		validator: validator,
+		cfg:       cfg,
+		watched:   newWatchSet(),
+		tokens:    tokens,
+		events:    make(chan Deposit, streamBufferSize),
+		tracker:   newBlockTracker(source, cfg.ReorgWindow),
+		pending:   make(map[string]Deposit),
+		byBlock:   make(map[uint64][]Deposit),
+	}, nil
+}
+
+// normalizeDepositToken 正規化代幣白名單項目：合約地址依鏈的格式正規化，十進位數字視為 TRC10 資產 ID
+func normalizeDepositToken(validator types.AddressValidator, token string) (string, error) {
+	if normalized, err := validator.ValidateAddress(token); err == nil {
+		return normalized, nil
+	}
+	if _, err := strconv.ParseUint(token, 10, 64); err == nil {
+		return token, nil
+	}
+	return "", fmt.Errorf("deposit scanner: invalid token %q: expected a contract address or TRC10 asset id", token)
+}
+
+// Watch 加入監控地址，地址經嚴格驗證與正規化
+func (s *DepositScanner) Watch(addr string) error {
+	normalized, err := s.validator.ValidateAddress(addr)
+	if err != nil {
+		return err
+	}
+	s.watched.add(normalized)
+	return nil
+}
+
+// Unwatch 移除監控地址
+func (s *DepositScanner) Unwatch(addr string) {
+	if normalized, err := s.validator.ValidateAddress(addr); err == nil {
+		s.watched.remove(normalized)
+	}
+}
+
+// Watched 列出監控地址
+func (s *DepositScanner) Watched() []string {
+	return s.watched.list()
+}
+
+// Events 回傳入帳事件 channel，Run 結束時關閉
+// 掃描器會等待事件被取走才推進進度，消費端停止讀取時掃描也會暫停
+func (s *DepositScanner) Events() <-chan Deposit {
+	return s.events
+}
+
+// Run 開始掃描直到 ctx 取消
+// 重啟時由保存的進度還原最近區塊與其中的入帳，停機期間發生的鏈重組於首次輪詢偵測；
+// 進度未保存最近區塊時，回溯 Confirmations-1 個區塊重新掃描以重建尚未確認的入帳
+// 偵測到鏈重組時，被回滾區塊中的入帳以 Removed 重新送出，並從共同祖先之後重新掃描
+func (s *DepositScanner) Run(ctx context.Context) error {
+	defer close(s.events)
+	if err := s.init(ctx); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if s.cfg.OnError != nil {
+				s.cfg.OnError(err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// init 決定起始掃描高度
+func (s *DepositScanner) init(ctx context.Context) error {
+	cursor, ok, err := s.cfg.Cursor.Load(s.cfg.Chain)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if len(cursor.Recent) > 0 {
+			return s.restore(cursor)
+		}
+		rescan := s.cfg.Confirmations - 1
+		if cursor.Block+1 > rescan {
+			s.next = cursor.Block + 1 - rescan
+		}
+		return nil
+	}
+	if s.cfg.StartBlock > 0 {
+		s.next = s.cfg.StartBlock
+		return nil
+	}
+	head, err := s.source.GetBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	s.next = head
+	return nil
+}
+
+// restore 由進度還原區塊追蹤與追蹤視窗內的入帳
+// 未達或剛達確認數的入帳重新列入待確認，於下次輪詢依目前確認數重新送出，避免停機前未送出的確認事件遺失
+func (s *DepositScanner) restore(cursor ScanCursor) error {
+	if err := s.tracker.restore(cursor.Recent); err != nil {
+		return err
+	}
+	for _, d := range cursor.Deposits {
+		s.byBlock[d.BlockNumber] = append(s.byBlock[d.BlockNumber], d)
+		if conf, ok := s.tracker.Confirmations(d.BlockNumber, d.BlockHash); ok && conf <= s.cfg.Confirmations {
+			d.Confirmations = 0
+			s.pending[d.key()] = d
+		}
+	}
+	last, _ := s.tracker.Last()
+	s.next = last.Number + 1
+	return nil
+}
+
+// saveCursor 保存進度，連同追蹤的區塊與其中的入帳
+func (s *DepositScanner) saveCursor() error {
+	last, ok := s.tracker.Last()
+	if !ok {
+		return nil
+	}
+	cursor := ScanCursor{Block: last.Number, Hash: last.Hash, Recent: append([]BlockRef(nil), s.tracker.blocks...)}
+	numbers := make([]uint64, 0, len(s.byBlock))
+	for n := range s.byBlock {
+		numbers = append(numbers, n)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	for _, n := range numbers {
+		cursor.Deposits = append(cursor.Deposits, s.byBlock[n]...)
+	}
+	return s.cfg.Cursor.Save(s.cfg.Chain, cursor)
+}
+
+// poll 掃描至最新區塊並更新未確認入帳的確認數
+func (s *DepositScanner) poll(ctx context.Context) error {
+	head, err := s.source.GetBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	reverted, err := s.tracker.VerifyTip(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.revert(ctx, reverted); err != nil {
+		return err
+	}
+	for s.next <= head {
+		ref, deposits, err := s.source.scanDepositBlock(ctx, s.next, s.watched, s.tokens)
+		if err != nil {
+			return err
+		}
+		// ErrStaleBlock 時不送出入帳也不保存進度，下次輪詢重試同一高度
+		reverted, err := s.tracker.Advance(ctx, ref)
+		if err != nil {
+			return err
+		}
+		if len(reverted) > 0 {
+			if err := s.revert(ctx, reverted); err != nil {
+				return err
+			}
+			continue
+		}
+		for i := range deposits {
+			deposits[i].Chain = s.cfg.Chain
+			deposits[i].BlockNumber = ref.Number
+			deposits[i].BlockHash = ref.Hash
+			if err := s.track(ctx, deposits[i]); err != nil {
+				return err
+			}
+		}
+		s.byBlock[ref.Number] = deposits
+		s.prune()
+		if err := s.saveCursor(); err != nil {
+			return err
+		}
+		s.next = ref.Number + 1
+	}
+	return s.updateConfirmations(ctx)
+}
+
+// revert 作廢被回滾區塊中的入帳，並將掃描進度退回共同祖先
+func (s *DepositScanner) revert(ctx context.Context, reverted []BlockRef) error {
+	if len(reverted) == 0 {
+		return nil
+	}
+	for i := len(reverted) - 1; i >= 0; i-- {
+		n := reverted[i].Number
+		for _, d := range s.byBlock[n] {
+			delete(s.pending, d.key())
+			d.Confirmations = 0
+			d.Confirmed = false
+			d.Removed = true
+			if err := s.emit(ctx, d); err != nil {
+				return err
+			}
+		}
+		delete(s.byBlock, n)
+	}
+	s.next = reverted[0].Number
+	return s.saveCursor()
+}
+
+// prune 移除已超出追蹤視窗的區塊入帳紀錄
+func (s *DepositScanner) prune() {
+	last, ok := s.tracker.Last()
+	if !ok || last.Number < uint64(s.cfg.ReorgWindow) {
+		return
+	}
+	oldest := last.Number - uint64(s.cfg.ReorgWindow)
+	for n := range s.byBlock {
+		if n <= oldest {
+			delete(s.byBlock, n)
+		}
+	}
+}
+
+// track 依主鏈計算確認數並送出入帳事件，未達確認數者加入待確認清單
+func (s *DepositScanner) track(ctx context.Context, d Deposit) error {
+	conf, ok := s.tracker.Confirmations(d.BlockNumber, d.BlockHash)
+	if !ok {
+		// 不在追蹤的主鏈上（或已超出視窗）時依最新追蹤高度估算
+		last, _ := s.tracker.Last()
+		conf = confirmations(d.BlockNumber, last.Number)
+	}
+	d.Confirmations = conf
+	d.Confirmed = d.Confirmations >= s.cfg.Confirmations
+	if d.Confirmed {
+		delete(s.pending, d.key())
+	} else {
+		s.pending[d.key()] = d
+	}
+	return s.emit(ctx, d)
+}
+
+// updateConfirmations 確認數有變化時重新送出待確認入帳
+func (s *DepositScanner) updateConfirmations(ctx context.Context) error {
+	last, ok := s.tracker.Last()
+	if !ok {
+		return nil
+	}
+	keys := make([]string, 0, len(s.pending))
+	for k := range s.pending {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		d := s.pending[k]
+		if confirmations(d.BlockNumber, last.Number) == d.Confirmations {
+			continue
+		}
+		if err := s.track(ctx, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// emit 送出事件，等待消費端取走或 ctx 取消
+func (s *DepositScanner) emit(ctx context.Context, d Deposit) error {
+	select {
+	case s.events <- d:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// confirmations 計算區塊確認數，區塊本身算一次確認
+func confirmations(block, head uint64) uint64 {
+	if head < block {
+		return 0
+	}
+	return head - block + 1
+}
+
+// watchSet 併發安全的監控地址集合
+type watchSet struct {
+	mu    sync.RWMutex
+	addrs map[string]struct{}
+}
+
+func newWatchSet() *watchSet {
+	return &watchSet{addrs: make(map[string]struct{})}
+}
+
+func (w *watchSet) add(addr string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.addrs[addr] = struct{}{}
+}
+
+func (w *watchSet) remove(addr string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.addrs, addr)
+}
+
+func (w *watchSet) contains(addr string) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	_, ok := w.addrs[addr]
+	return ok
+}
+
+func (w *watchSet) list() []string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	list := make([]string, 0, len(w.addrs))
+	for a := range w.addrs {
+		list = append(list, a)
+	}
+	sort.Strings(list)
+	return list
+}
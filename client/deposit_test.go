@@ -0,0 +1,377 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// fakeDepositSource 測試用區塊來源，deposits 依區塊高度給定入帳
+// forkAt 非 0 時，該高度以上的區塊改為分叉鏈（雜湊加上 "b"）
+type fakeDepositSource struct {
+	head     uint64
+	deposits map[uint64][]Deposit
+	forkAt   uint64
+}
+
+func (f *fakeDepositSource) hash(n uint64) string {
+	if f.forkAt != 0 && n >= f.forkAt {
+		return fmt.Sprintf("h%db", n)
+	}
+	return fmt.Sprintf("h%d", n)
+}
+
+func (f *fakeDepositSource) blockRefByNumber(ctx context.Context, number uint64) (BlockRef, error) {
+	return BlockRef{Number: number, Hash: f.hash(number), ParentHash: f.hash(number - 1)}, nil
+}
+
+func (f *fakeDepositSource) Connect(ctx context.Context, url string) error { return nil }
+func (f *fakeDepositSource) Close() error                                  { return nil }
+func (f *fakeDepositSource) ValidateAddress(addr string) (string, error)   { return addr, nil }
+
+func (f *fakeDepositSource) GetBlockNumber(ctx context.Context) (uint64, error) {
+	return f.head, nil
+}
+
+func (f *fakeDepositSource) scanDepositBlock(ctx context.Context, number uint64, watched, tokens *watchSet) (BlockRef, []Deposit, error) {
+	ref, _ := f.blockRefByNumber(ctx, number)
+	var out []Deposit
+	for _, d := range f.deposits[number] {
+		if watched.contains(d.To) && (d.Token == "" || tokens.contains(d.Token)) {
+			out = append(out, d)
+		}
+	}
+	return ref, out, nil
+}
+
+func drain(ch <-chan Deposit) []Deposit {
+	var out []Deposit
+	for {
+		select {
+		case d := <-ch:
+			out = append(out, d)
+		default:
+			return out
+		}
+	}
+}
+
+func TestDepositScanner_Confirmations(t *testing.T) {
+	src := &fakeDepositSource{head: 10, deposits: map[uint64][]Deposit{
+		10: {{TxHash: "tx1", To: "alice", Amount: "100"}, {TxHash: "tx2", To: "bob", Amount: "5"}},
+	}}
+	cursor := NewMemoryCursorStore()
+	s, err := NewDepositScanner(src, DepositScannerConfig{Chain: "test", Confirmations: 3, Cursor: cursor})
+	if err != nil {
+		t.Fatalf("NewDepositScanner failed: %v", err)
+	}
+	if err := s.Watch("alice"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err := s.init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, head := range []uint64{10, 11, 11, 12} {
+		src.head = head
+		if err := s.poll(ctx); err != nil {
+			t.Fatalf("poll failed: %v", err)
+		}
+	}
+	events := drain(s.Events())
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d: %+v", len(events), events)
+	}
+	for i, d := range events {
+		if d.TxHash != "tx1" || d.Chain != "test" || d.BlockNumber != 10 || d.BlockHash != "h10" {
+			t.Errorf("unexpected event %d: %+v", i, d)
+		}
+		if d.Confirmations != uint64(i+1) {
+			t.Errorf("event %d: expected %d confirmations, got %d", i, i+1, d.Confirmations)
+		}
+	}
+	if !events[2].Confirmed || events[1].Confirmed {
+		t.Errorf("only the last event should be confirmed: %+v", events)
+	}
+	if c, ok, _ := cursor.Load("test"); !ok || c.Block != 12 || c.Hash != "h12" {
+		t.Errorf("unexpected cursor %+v", c)
+	}
+}
+
+func TestDepositScanner_Reorg(t *testing.T) {
+	src := &fakeDepositSource{head: 12, deposits: map[uint64][]Deposit{
+		11: {{TxHash: "tx1", To: "alice", Amount: "100"}},
+	}}
+	s, err := NewDepositScanner(src, DepositScannerConfig{Chain: "test", Confirmations: 3, StartBlock: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Watch("alice"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err := s.init(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// 確認數依已追蹤的主鏈高度計算，掃描到 12 後更新為 2
+	if events := drain(s.Events()); len(events) != 2 || events[1].Confirmations != 2 {
+		t.Fatalf("unexpected events before reorg: %+v", events)
+	}
+
+	// 區塊 11 起被分叉鏈取代，tx1 改為打包在 12
+	src.forkAt = 11
+	src.head = 13
+	src.deposits = map[uint64][]Deposit{12: {{TxHash: "tx1", To: "alice", Amount: "100"}}}
+	if err := s.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	events := drain(s.Events())
+	if len(events) != 3 {
+		t.Fatalf("expected removal and re-detection, got %+v", events)
+	}
+	if !events[0].Removed || events[0].BlockHash != "h11" || events[0].Confirmed {
+		t.Errorf("expected removal of the reverted deposit, got %+v", events[0])
+	}
+	if events[2].Removed || events[2].BlockHash != "h12b" || events[2].Confirmations != 2 {
+		t.Errorf("expected deposit on the canonical chain, got %+v", events[2])
+	}
+	if len(s.pending) != 1 || s.pending[events[2].key()].BlockHash != "h12b" {
+		t.Errorf("pending should only hold canonical deposits: %+v", s.pending)
+	}
+}
+
+// staleDepositSource 第一次掃描 staleAt 時回傳父區塊雜湊不符的區塊，模擬落後的 RPC 節點
+type staleDepositSource struct {
+	*fakeDepositSource
+	staleAt uint64
+}
+
+func (f *staleDepositSource) scanDepositBlock(ctx context.Context, number uint64, watched, tokens *watchSet) (BlockRef, []Deposit, error) {
+	ref, deposits, err := f.fakeDepositSource.scanDepositBlock(ctx, number, watched, tokens)
+	if number == f.staleAt {
+		f.staleAt = 0
+		ref.Hash, ref.ParentHash = ref.Hash+"x", "stale"
+	}
+	return ref, deposits, err
+}
+
+func TestDepositScanner_StaleBlock(t *testing.T) {
+	src := &staleDepositSource{fakeDepositSource: &fakeDepositSource{head: 12, deposits: map[uint64][]Deposit{
+		11: {{TxHash: "tx1", To: "alice", Amount: "100"}},
+	}}, staleAt: 11}
+	cursor := NewMemoryCursorStore()
+	s, err := NewDepositScanner(src, DepositScannerConfig{Chain: "test", Confirmations: 3, StartBlock: 10, Cursor: cursor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Watch("alice"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err := s.init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 不一致的區塊不送出入帳、不保存進度
+	if err := s.poll(ctx); !errors.Is(err, ErrStaleBlock) {
+		t.Fatalf("expected ErrStaleBlock, got %v", err)
+	}
+	if events := drain(s.Events()); len(events) != 0 {
+		t.Errorf("stale block must not emit deposits: %+v", events)
+	}
+	if c, _, _ := cursor.Load("test"); c.Block != 10 || s.next != 11 {
+		t.Errorf("expected cursor at 10 and retry of 11, got %+v next %d", c, s.next)
+	}
+
+	// 下次輪詢重試同一高度並繼續前進
+	if err := s.poll(ctx); err != nil {
+		t.Fatalf("retry failed: %v", err)
+	}
+	events := drain(s.Events())
+	if len(events) == 0 || events[0].BlockHash != "h11" {
+		t.Errorf("expected deposit from canonical block 11, got %+v", events)
+	}
+	if c, _, _ := cursor.Load("test"); c.Block != 12 || c.Hash != "h12" {
+		t.Errorf("unexpected cursor %+v", c)
+	}
+}
+
+func TestDepositScanner_ResumeFromCursor(t *testing.T) {
+	cursor := NewMemoryCursorStore()
+	_ = cursor.Save("test", ScanCursor{Block: 50, Hash: "h50"})
+	s, err := NewDepositScanner(&fakeDepositSource{head: 60}, DepositScannerConfig{Chain: "test", Confirmations: 4, Cursor: cursor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.init(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// 回溯 Confirmations-1 個區塊以重建未確認入帳
+	if s.next != 48 {
+		t.Errorf("expected to resume from block 48, got %d", s.next)
+	}
+}
+
+func TestDepositScanner_ReorgWhileStopped(t *testing.T) {
+	src := &fakeDepositSource{head: 12, deposits: map[uint64][]Deposit{
+		11: {{TxHash: "tx1", To: "alice", Amount: "100"}},
+	}}
+	cursor := NewMemoryCursorStore()
+	newScanner := func() *DepositScanner {
+		s, err := NewDepositScanner(src, DepositScannerConfig{Chain: "test", Confirmations: 3, StartBlock: 10, Cursor: cursor})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Watch("alice"); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.init(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	ctx := context.Background()
+	s := newScanner()
+	if err := s.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	drain(s.Events())
+
+	// 停機期間區塊 11 起被分叉鏈取代，tx1 改為打包在 12
+	src.forkAt = 11
+	src.head = 13
+	src.deposits = map[uint64][]Deposit{12: {{TxHash: "tx1", To: "alice", Amount: "100"}}}
+	s = newScanner()
+	if s.next != 13 {
+		t.Errorf("expected to resume from block 13, got %d", s.next)
+	}
+	if err := s.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	events := drain(s.Events())
+	if len(events) < 2 || !events[0].Removed || events[0].BlockHash != "h11" {
+		t.Fatalf("expected removal of the deposit in the orphaned block, got %+v", events)
+	}
+	if last := events[len(events)-1]; last.Removed || last.BlockHash != "h12b" || last.Confirmations != 2 {
+		t.Errorf("expected deposit on the canonical chain, got %+v", last)
+	}
+	if c, _, _ := cursor.Load("test"); c.Block != 13 || c.Hash != "h13b" || len(c.Deposits) != 1 || c.Deposits[0].BlockHash != "h12b" {
+		t.Errorf("unexpected cursor %+v", c)
+	}
+}
+
+func TestNewDepositScanner_Unsupported(t *testing.T) {
+	if _, err := NewDepositScanner(&fakeSubscriberClient{}, DepositScannerConfig{Chain: "x"}); err != ErrUnsupportedBlockchain {
+		t.Errorf("expected ErrUnsupportedBlockchain, got %v", err)
+	}
+}
+
+// fakeSubscriberClient 不支援入帳掃描的 client
+type fakeSubscriberClient struct{}
+
+func (f *fakeSubscriberClient) Connect(ctx context.Context, url string) error { return nil }
+func (f *fakeSubscriberClient) Close() error                                  { return nil }
+
+func TestEthereumScanDepositBlock_OPDepositTx(t *testing.T) {
+	to := common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	block := newOPBlock(t, to)
+	receipt := &ethtypes.Receipt{
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 21000,
+		GasUsed:           21000,
+		TxHash:            block.tx.Hash(),
+		BlockNumber:       big.NewInt(100),
+		Logs:              []*ethtypes.Log{},
+	}
+	e := newFakeEVMNode(t, map[string]rpcHandler{
+		"eth_getBlockByNumber":      block.handler(t),
+		"eth_getTransactionReceipt": func([]json.RawMessage) interface{} { return receipt },
+		"eth_getLogs":               func([]json.RawMessage) interface{} { return []interface{}{} },
+	})
+	watched := newWatchSet()
+	watched.add(to.Hex())
+
+	// deposit 交易同樣轉給監控地址，須略過而非使整個區塊失敗
+	ref, deposits, err := e.scanDepositBlock(context.Background(), 100, watched, newWatchSet())
+	if err != nil {
+		t.Fatalf("scanDepositBlock failed: %v", err)
+	}
+	if ref.Number != 100 || ref.Hash != block.header.Hash().Hex() {
+		t.Errorf("unexpected block ref %+v", ref)
+	}
+	if len(deposits) != 1 || deposits[0].TxHash != block.tx.Hash().Hex() || deposits[0].From != block.from.Hex() || deposits[0].Amount != "1000000000000000" {
+		t.Errorf("unexpected deposits %+v", deposits)
+	}
+}
+
+func TestEthereumScanDepositBlock_TokenAllowlist(t *testing.T) {
+	to := common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	listed := common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7")
+	fake := common.HexToAddress("0x00000000000000000000000000000000000bad01")
+	block := newOPBlock(t, common.HexToAddress("0x01"))
+	transfer := func(token common.Address, index uint) *ethtypes.Log {
+		return &ethtypes.Log{
+			Address: token,
+			Topics:  []common.Hash{transferEventSig, common.BytesToHash(block.from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    common.LeftPadBytes(big.NewInt(500).Bytes(), 32),
+			TxHash:  block.tx.Hash(),
+			Index:   index,
+		}
+	}
+	var query struct {
+		Address []common.Address `json:"address"`
+	}
+	e := newFakeEVMNode(t, map[string]rpcHandler{
+		"eth_getBlockByNumber": block.handler(t),
+		// 模擬未套用地址過濾的節點，回傳白名單外合約的 Transfer 事件
+		"eth_getLogs": func(params []json.RawMessage) interface{} {
+			json.Unmarshal(params[0], &query)
+			return []*ethtypes.Log{transfer(fake, 0), transfer(listed, 1)}
+		},
+	})
+	watched := newWatchSet()
+	watched.add(to.Hex())
+	tokens := newWatchSet()
+	tokens.add(listed.Hex())
+
+	_, deposits, err := e.scanDepositBlock(context.Background(), 100, watched, tokens)
+	if err != nil {
+		t.Fatalf("scanDepositBlock failed: %v", err)
+	}
+	if len(query.Address) != 1 || query.Address[0] != listed {
+		t.Errorf("expected log filter on listed token, got %v", query.Address)
+	}
+	if len(deposits) != 1 || deposits[0].Token != listed.Hex() || deposits[0].Amount != "500" {
+		t.Errorf("expected only the listed token deposit, got %+v", deposits)
+	}
+
+	// 未設定白名單時不查詢代幣事件
+	query.Address = nil
+	if _, deposits, err := e.scanDepositBlock(context.Background(), 100, watched, newWatchSet()); err != nil || len(deposits) != 0 || query.Address != nil {
+		t.Errorf("expected no token deposits without allowlist, got %+v, %v", deposits, err)
+	}
+}
+
+func TestNewDepositScanner_Tokens(t *testing.T) {
+	eth := &EthereumClient{network: Network{Name: "eth"}}
+	s, err := NewDepositScanner(eth, DepositScannerConfig{Tokens: []string{"0xdac17f958d2ee523a2206206994597c13d831ec7"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.tokens.list(); len(got) != 1 || got[0] != "0xdAC17F958D2ee523a2206206994597C13D831ec7" {
+		t.Errorf("expected checksummed token, got %v", got)
+	}
+	if _, err := NewDepositScanner(eth, DepositScannerConfig{Tokens: []string{"not-a-token"}}); err == nil {
+		t.Error("expected error for invalid token")
+	}
+}
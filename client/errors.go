@@ -6,4 +6,10 @@ import "errors"
 var (
 	// ErrUnsupportedBlockchain is returned when an unsupported blockchain type is specified
 	ErrUnsupportedBlockchain = errors.New("unsupported blockchain type")
+	// ErrInvalidAddress is returned when an address is malformed for the target chain
+	ErrInvalidAddress = errors.New("invalid address")
+	// ErrInvalidChecksum is returned when a mixed-case Ethereum address fails EIP-55 validation
+	ErrInvalidChecksum = errors.New("invalid address checksum")
+	// ErrUnsupportedPermission is returned when a multi-signature transaction names a permission other than owner or an existing active permission
+	ErrUnsupportedPermission = errors.New("unsupported permission: only owner and active permissions can sign transfers")
 )
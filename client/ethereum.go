@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
 	"strings"
 
@@ -18,26 +19,154 @@ import (
 )
 
 // EthereumClient 實作 BlockchainClient, WalletManager, TokenManager, ContractManager
+// 同一實作適用所有 EVM 相容網路，network 為零值時沿用節點回報的鏈 ID 與 legacy 手續費
 
 type EthereumClient struct {
-	rpcURL string
-	client *ethclient.Client
+	rpcURL  string
+	client  *ethclient.Client
+	network Network
+	oracle  *FeeOracle
 }
 
 var _ types.BlockchainClient = (*EthereumClient)(nil)
 var _ types.WalletManager = (*EthereumClient)(nil)
 var _ types.TokenManager = (*EthereumClient)(nil)
 var _ types.ContractManager = (*EthereumClient)(nil)
+var _ types.AddressValidator = (*EthereumClient)(nil)
+var _ types.NetworkInfoProvider = (*EthereumClient)(nil)
+var _ types.GasFeeOracle = (*EthereumClient)(nil)
+var _ types.TokenContractManager = (*EthereumClient)(nil)
+var _ types.CapabilityProvider = (*EthereumClient)(nil)
+
+// ethereumCapabilities EVM client 提供的功能，註冊表與 /capabilities 共用
+var ethereumCapabilities = types.CapabilitySet{
+	types.CapabilityWallet,
+	types.CapabilityNativeTransfer,
+	types.CapabilityTokens,
+	types.CapabilityContractDeploy,
+	types.CapabilityContractCall,
+	types.CapabilityBlocks,
+	types.CapabilityEvents,
+	types.CapabilityMessageSigning,
+	types.CapabilityPermit,
+	types.CapabilityGasFees,
+}
+
+func init() {
+	Register(Chain{
+		Type:         Ethereum,
+		Description:  "Ethereum and EVM compatible networks",
+		Capabilities: ethereumCapabilities,
+		New:          NewEthereumClient,
+		Instances:    evmInstances,
+	})
+}
+
+// Capabilities 實作 CapabilityProvider 介面
+func (e *EthereumClient) Capabilities() types.CapabilitySet {
+	return ethereumCapabilities
+}
 
 // Connect 實作 BlockchainClient 介面
+// url 為空時依序嘗試網路設定的 RPC 位址；網路設定了鏈 ID 時會校驗節點的鏈 ID
 func (e *EthereumClient) Connect(ctx context.Context, url string) error {
+	urls := []string{url}
+	if url == "" {
+		urls = e.network.RPCURLs
+	}
+	if len(urls) == 0 {
+		return fmt.Errorf("no RPC URL configured for network %q", e.network.Name)
+	}
+	var lastErr error
+	for _, u := range urls {
+		cli, err := e.dial(ctx, u)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		e.rpcURL = u
+		e.client = cli
+		e.oracle = newFeeOracle(cli, e.network)
+		return nil
+	}
+	return lastErr
+}
+
+// dial 連線單一 RPC 位址並校驗鏈 ID
+func (e *EthereumClient) dial(ctx context.Context, url string) (*ethclient.Client, error) {
 	cli, err := ethclient.DialContext(ctx, url)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	e.rpcURL = url
-	e.client = cli
-	return nil
+	if e.network.ChainID == 0 {
+		return cli, nil
+	}
+	chainID, err := cli.ChainID(ctx)
+	if err != nil {
+		cli.Close()
+		return nil, err
+	}
+	if chainID.Int64() != e.network.ChainID {
+		cli.Close()
+		return nil, fmt.Errorf("network %q expects chain id %d, node %s reports %s", e.network.Name, e.network.ChainID, url, chainID)
+	}
+	return cli, nil
+}
+
+// Network 回傳此 client 的網路設定
+func (e *EthereumClient) Network() Network {
+	return e.network
+}
+
+// NetworkInfo 實作 NetworkInfoProvider 介面
+func (e *EthereumClient) NetworkInfo() types.NetworkResponse {
+	return types.NetworkResponse{
+		Name:         e.network.Name,
+		ChainID:      e.network.ChainID,
+		NativeSymbol: e.network.NativeSymbol,
+		FeeModel:     string(e.network.FeeModel),
+		ExplorerURL:  e.network.ExplorerURL,
+		Connected:    e.client != nil,
+	}
+}
+
+// chainID 回傳簽名用的鏈 ID，優先使用網路設定
+func (e *EthereumClient) chainID(ctx context.Context) (*big.Int, error) {
+	if e.network.ChainID > 0 {
+		return big.NewInt(e.network.ChainID), nil
+	}
+	return e.client.ChainID(ctx)
+}
+
+// newTransaction 依網路手續費模型建立未簽名交易，手續費取自預言機設定的檔位
+// EIP-1559 網路使用 DynamicFeeTx，其餘使用 legacy gasPrice
+func (e *EthereumClient) newTransaction(ctx context.Context, chainID *big.Int, nonce uint64, to common.Address, value *big.Int, gasLimit uint64, data []byte) (*ethtypes.Transaction, error) {
+	oracle, err := e.feeOracle()
+	if err != nil {
+		return nil, err
+	}
+	fee, err := oracle.Suggest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if e.network.FeeModel != FeeModelEIP1559 {
+		return ethtypes.NewTransaction(nonce, to, value, gasLimit, fee.GasPrice, data), nil
+	}
+	return ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: fee.TipCap,
+		GasFeeCap: fee.FeeCap,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	}), nil
+}
+
+// ValidateAddress 實作 AddressValidator 介面，回傳 EIP-55 校驗格式地址
+func (e *EthereumClient) ValidateAddress(address string) (string, error) {
+	return ValidateEthereumAddress(address)
 }
 
 // GenerateNewWallet 實作 WalletManager 介面
@@ -57,7 +186,10 @@ func (e *EthereumClient) GetNativeBalance(ctx context.Context, address string) (
 	if e.client == nil {
 		return nil, errors.New("Ethereum client not connected")
 	}
-	acc := common.HexToAddress(address)
+	acc, err := parseEthereumAddress(address)
+	if err != nil {
+		return nil, err
+	}
 	balance, err := e.client.BalanceAt(ctx, acc, nil)
 	if err != nil {
 		return nil, err
@@ -71,6 +203,10 @@ func (e *EthereumClient) SendNativeToken(ctx context.Context, fromPrivateKey, to
 	if e.client == nil {
 		return "", errors.New("Ethereum client not connected")
 	}
+	to, err := parseEthereumAddress(toAddress)
+	if err != nil {
+		return "", err
+	}
 	priv, err := crypto.HexToECDSA(fromPrivateKey)
 	if err != nil {
 		return "", err
@@ -84,17 +220,15 @@ func (e *EthereumClient) SendNativeToken(ctx context.Context, fromPrivateKey, to
 	amountWei := new(big.Float).Mul(amount, big.NewFloat(1e18))
 	amountWei.Int(valueWei)
 	gasLimit := uint64(21000)
-	gasPrice, err := e.client.SuggestGasPrice(ctx)
+	chainID, err := e.chainID(ctx)
 	if err != nil {
 		return "", err
 	}
-	to := common.HexToAddress(toAddress)
-	tx := ethtypes.NewTransaction(nonce, to, valueWei, gasLimit, gasPrice, nil)
-	chainID, err := e.client.NetworkID(ctx)
+	tx, err := e.newTransaction(ctx, chainID, nonce, to, valueWei, gasLimit, nil)
 	if err != nil {
 		return "", err
 	}
-	signedTx, err := ethtypes.SignTx(tx, ethtypes.NewEIP155Signer(chainID), priv)
+	signedTx, err := ethtypes.SignTx(tx, ethtypes.LatestSignerForChainID(chainID), priv)
 	if err != nil {
 		return "", err
 	}
@@ -106,44 +240,63 @@ func (e *EthereumClient) SendNativeToken(ctx context.Context, fromPrivateKey, to
 }
 
 // DeployContract 实现 ContractManager
-func (e *EthereumClient) DeployContract(ctx context.Context, privateKey, bytecode, abiJSON string, constructorArgs []interface{}) (string, error) {
+func (e *EthereumClient) DeployContract(ctx context.Context, privateKey, bytecode, abiJSON string, constructorArgs []interface{}, opts types.DeployOptions) (string, string, error) {
 	if e.client == nil {
-		return "", errors.New("Ethereum client not connected")
+		return "", "", errors.New("Ethereum client not connected")
 	}
 	priv, err := crypto.HexToECDSA(privateKey)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	fromAddr := crypto.PubkeyToAddress(priv.PublicKey)
 	nonce, err := e.client.PendingNonceAt(ctx, fromAddr)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
-	gasPrice, err := e.client.SuggestGasPrice(ctx)
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
-	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	args, err := convertABIArgs(parsedABI.Constructor.Inputs, constructorArgs, parseEthereumAddress)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	bytecodeBytes, err := hex.DecodeString(strings.TrimPrefix(bytecode, "0x"))
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
-	auth, err := bind.NewKeyedTransactorWithChainID(priv, big.NewInt(1)) // 1=mainnet, 可根据实际链ID调整
+	chainID, err := e.chainID(ctx)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
+	auth, err := bind.NewKeyedTransactorWithChainID(priv, chainID)
+	if err != nil {
+		return "", "", err
+	}
+	auth.Context = ctx
 	auth.Nonce = big.NewInt(int64(nonce))
 	auth.Value = big.NewInt(0)
 	auth.GasLimit = uint64(3000000)
-	auth.GasPrice = gasPrice
-	address, _, _, err := bind.DeployContract(auth, parsedABI, bytecodeBytes, e.client, constructorArgs...)
+	oracle, err := e.feeOracle()
 	if err != nil {
-		return "", err
+		return "", "", err
+	}
+	fee, err := oracle.Suggest(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	// 設定 GasPrice 時 bind 建立 legacy 交易，否則依 GasTipCap/GasFeeCap 建立 EIP-1559 交易
+	if e.network.FeeModel != FeeModelEIP1559 {
+		auth.GasPrice = fee.GasPrice
+	} else {
+		auth.GasTipCap = fee.TipCap
+		auth.GasFeeCap = fee.FeeCap
 	}
-	return address.Hex(), nil
+	address, tx, _, err := bind.DeployContract(auth, parsedABI, bytecodeBytes, e.client, args...)
+	if err != nil {
+		return "", "", err
+	}
+	return address.Hex(), tx.Hash().Hex(), nil
 }
 
 // CallContract 实现 ContractManager
@@ -151,12 +304,11 @@ func (e *EthereumClient) CallContract(ctx context.Context, contractAddress, abiJ
 	if e.client == nil {
 		return nil, errors.New("Ethereum client not connected")
 	}
-	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	contract, err := parseEthereumAddress(contractAddress)
 	if err != nil {
 		return nil, err
 	}
-	contract := common.HexToAddress(contractAddress)
-	callData, err := parsedABI.Pack(method, params...)
+	parsedABI, callData, err := packContractCall(abiJSON, method, params, parseEthereumAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -168,11 +320,7 @@ func (e *EthereumClient) CallContract(ctx context.Context, contractAddress, abiJ
 	if err != nil {
 		return nil, err
 	}
-	var result interface{}
-	if err := parsedABI.UnpackIntoInterface(&result, method, output); err != nil {
-		return nil, err
-	}
-	return result, nil
+	return decodeContractOutput(parsedABI, method, output, formatEthereumAddress)
 }
 
 // ERC20 余额查询
@@ -181,8 +329,15 @@ func (e *EthereumClient) GetERC20Balance(ctx context.Context, contractAddress, w
 	if err != nil {
 		return nil, err
 	}
-	contract := common.HexToAddress(contractAddress)
-	data, err := parsedABI.Pack("balanceOf", common.HexToAddress(walletAddress))
+	contract, err := parseEthereumAddress(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := parseEthereumAddress(walletAddress)
+	if err != nil {
+		return nil, err
+	}
+	data, err := parsedABI.Pack("balanceOf", wallet)
 	if err != nil {
 		return nil, err
 	}
@@ -208,7 +363,15 @@ func (e *EthereumClient) TransferERC20(ctx context.Context, privateKey, contract
 	if err != nil {
 		return "", err
 	}
-	data, err := parsedABI.Pack("transfer", common.HexToAddress(toAddress), amount)
+	to, err := parseEthereumAddress(toAddress)
+	if err != nil {
+		return "", err
+	}
+	contract, err := parseEthereumAddress(contractAddress)
+	if err != nil {
+		return "", err
+	}
+	data, err := parsedABI.Pack("transfer", to, amount)
 	if err != nil {
 		return "", err
 	}
@@ -216,17 +379,15 @@ func (e *EthereumClient) TransferERC20(ctx context.Context, privateKey, contract
 	if err != nil {
 		return "", err
 	}
-	gasPrice, err := e.client.SuggestGasPrice(ctx)
+	chainID, err := e.chainID(ctx)
 	if err != nil {
 		return "", err
 	}
-	contract := common.HexToAddress(contractAddress)
-	tx := ethtypes.NewTransaction(nonce, contract, big.NewInt(0), uint64(60000), gasPrice, data)
-	chainID, err := e.client.NetworkID(ctx)
+	tx, err := e.newTransaction(ctx, chainID, nonce, contract, big.NewInt(0), uint64(60000), data)
 	if err != nil {
 		return "", err
 	}
-	signedTx, err := ethtypes.SignTx(tx, ethtypes.NewEIP155Signer(chainID), priv)
+	signedTx, err := ethtypes.SignTx(tx, ethtypes.LatestSignerForChainID(chainID), priv)
 	if err != nil {
 		return "", err
 	}
@@ -237,12 +398,70 @@ func (e *EthereumClient) TransferERC20(ctx context.Context, privateKey, contract
 	return signedTx.Hash().Hex(), nil
 }
 
+// GetTokenBalance 實作 TokenContractManager 介面，依 decimals 換算餘額
+func (e *EthereumClient) GetTokenBalance(ctx context.Context, contractAddress, walletAddress string) (*big.Float, error) {
+	decimals, err := e.erc20Decimals(ctx, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := e.GetERC20Balance(ctx, contractAddress, walletAddress)
+	if err != nil {
+		return nil, err
+	}
+	return fromSmallestUnit(balance, decimals), nil
+}
+
+// SendToken 實作 TokenContractManager 介面，依 decimals 將金額換算為最小單位
+func (e *EthereumClient) SendToken(ctx context.Context, fromPrivateKey, contractAddress, toAddress string, amount *big.Float) (string, error) {
+	decimals, err := e.erc20Decimals(ctx, contractAddress)
+	if err != nil {
+		return "", err
+	}
+	value, err := toSmallestUnit(amount, decimals)
+	if err != nil {
+		return "", err
+	}
+	return e.TransferERC20(ctx, fromPrivateKey, contractAddress, toAddress, value)
+}
+
+// erc20Decimals 查詢代幣 decimals
+func (e *EthereumClient) erc20Decimals(ctx context.Context, contractAddress string) (uint8, error) {
+	if e.client == nil {
+		return 0, errors.New("Ethereum client not connected")
+	}
+	contract, err := parseEthereumAddress(contractAddress)
+	if err != nil {
+		return 0, err
+	}
+	data, err := erc20ABI.Pack("decimals")
+	if err != nil {
+		return 0, err
+	}
+	out, err := e.client.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: data}, nil)
+	if err != nil {
+		return 0, err
+	}
+	values, err := erc20ABI.Unpack("decimals", out)
+	if err != nil {
+		return 0, fmt.Errorf("decode decimals result: %w", err)
+	}
+	decimals, ok := values[0].(uint8)
+	if !ok {
+		return 0, errors.New("unexpected decimals result")
+	}
+	return decimals, nil
+}
+
 // ERC20 ABI 常量
 const ERC20ABI = `[
   {"constant":true,"inputs":[{"name":"_owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"balance","type":"uint256"}],"type":"function"},
-  {"constant":false,"inputs":[{"name":"_to","type":"address"},{"name":"_value","type":"uint256"}],"name":"transfer","outputs":[{"name":"success","type":"bool"}],"type":"function"}
+  {"constant":false,"inputs":[{"name":"_to","type":"address"},{"name":"_value","type":"uint256"}],"name":"transfer","outputs":[{"name":"success","type":"bool"}],"type":"function"},
+  {"constant":true,"inputs":[],"name":"decimals","outputs":[{"name":"","type":"uint8"}],"type":"function"}
 ]`
 
+// erc20ABI 解析後的 ERC20ABI，TRC20 共用
+var erc20ABI = mustParseABI(ERC20ABI)
+
 // Close 實作 BlockchainClient 介面
 func (e *EthereumClient) Close() error {
 	if e.client != nil {
@@ -0,0 +1,206 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+var _ types.BlockReader = (*EthereumClient)(nil)
+
+// GetBlockNumber 实现 BlockReader
+func (e *EthereumClient) GetBlockNumber(ctx context.Context) (uint64, error) {
+	if e.client == nil {
+		return 0, errors.New("Ethereum client not connected")
+	}
+	return e.client.BlockNumber(ctx)
+}
+
+// GetBlock 实现 BlockReader
+// 交易只取雜湊，不解碼交易內容，OP deposit（0x7e）等 go-ethereum 不支援的交易類型也能查詢
+func (e *EthereumClient) GetBlock(ctx context.Context, block string) (*types.BlockResponse, error) {
+	if e.client == nil {
+		return nil, errors.New("Ethereum client not connected")
+	}
+	id, err := parseBlockID(block)
+	if err != nil {
+		return nil, err
+	}
+	header, body, err := e.fetchBlock(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]string, 0, len(body.Transactions))
+	for _, raw := range body.Transactions {
+		var hash common.Hash
+		if err := json.Unmarshal(raw, &hash); err != nil {
+			return nil, fmt.Errorf("invalid transaction hash in block: %w", err)
+		}
+		txs = append(txs, hash.Hex())
+	}
+	return &types.BlockResponse{
+		Number:       header.Number.Uint64(),
+		Hash:         header.Hash().Hex(),
+		ParentHash:   header.ParentHash.Hex(),
+		Timestamp:    int64(header.Time),
+		Producer:     header.Coinbase.Hex(),
+		TxCount:      len(txs),
+		Transactions: txs,
+	}, nil
+}
+
+// rpcBlock eth_getBlockBy* 回應中的交易列表，交易保留原始 JSON 由呼叫端解碼
+type rpcBlock struct {
+	Transactions []json.RawMessage `json:"transactions"`
+}
+
+// fetchBlock 直接呼叫 eth_getBlockByNumber / eth_getBlockByHash，標頭與交易取自同一回應
+// ethclient 的 BlockByNumber 會解碼所有交易，遇到 OP deposit（0x7e）或 Arbitrum 內部交易時整個區塊失敗
+// fullTx 為 false 時交易只含雜湊
+func (e *EthereumClient) fetchBlock(ctx context.Context, id blockID, fullTx bool) (*ethtypes.Header, rpcBlock, error) {
+	method, arg := "eth_getBlockByNumber", interface{}("latest")
+	switch {
+	case id.hash != "":
+		method, arg = "eth_getBlockByHash", common.HexToHash(id.hash)
+	case !id.latest:
+		arg = hexutil.EncodeUint64(id.number)
+	}
+	var raw json.RawMessage
+	if err := e.client.Client().CallContext(ctx, &raw, method, arg, fullTx); err != nil {
+		return nil, rpcBlock{}, err
+	}
+	var header *ethtypes.Header
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, rpcBlock{}, err
+	}
+	if header == nil {
+		return nil, rpcBlock{}, ethereum.NotFound
+	}
+	var body rpcBlock
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, rpcBlock{}, err
+	}
+	return header, body, nil
+}
+
+// GetTransaction 实现 BlockReader
+// 直接呼叫 eth_getTransactionByHash，from、區塊與雜湊取自節點回應，不在本地解碼交易或還原簽名者，
+// OP deposit（0x7e）等 go-ethereum 不支援的交易類型也能查詢
+func (e *EthereumClient) GetTransaction(ctx context.Context, txHash string) (*types.TransactionDetailResponse, error) {
+	if e.client == nil {
+		return nil, errors.New("Ethereum client not connected")
+	}
+	hash, err := parseTxHash(txHash)
+	if err != nil {
+		return nil, err
+	}
+	var tx *rpcTransaction
+	if err := e.client.Client().CallContext(ctx, &tx, "eth_getTransactionByHash", hash); err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, ethereum.NotFound
+	}
+	detail := &types.TransactionDetailResponse{
+		TxHash:  tx.Hash.Hex(),
+		Pending: tx.BlockNumber == nil,
+		Type:    strconv.FormatUint(uint64(tx.Type), 10),
+		From:    tx.From.Hex(),
+		Value:   "0",
+		Nonce:   uint64(tx.Nonce),
+	}
+	if tx.Value != nil {
+		detail.Value = tx.Value.ToInt().String()
+	}
+	if tx.To != nil {
+		detail.To = tx.To.Hex()
+	}
+	if len(tx.Input) > 0 {
+		detail.Input = tx.Input.String()
+	}
+	if tx.BlockNumber != nil {
+		detail.BlockNumber = tx.BlockNumber.ToInt().Uint64()
+	}
+	if tx.BlockHash != nil {
+		detail.BlockHash = tx.BlockHash.Hex()
+	}
+	return detail, nil
+}
+
+// rpcTransaction eth_getTransactionByHash 回應中查詢所需的欄位，各類型交易共通
+// 待打包交易的 blockNumber 與 blockHash 為 null
+type rpcTransaction struct {
+	Hash        common.Hash     `json:"hash"`
+	Type        hexutil.Uint64  `json:"type"`
+	From        common.Address  `json:"from"`
+	To          *common.Address `json:"to"`
+	Value       *hexutil.Big    `json:"value"`
+	Nonce       hexutil.Uint64  `json:"nonce"`
+	Input       hexutil.Bytes   `json:"input"`
+	BlockNumber *hexutil.Big    `json:"blockNumber"`
+	BlockHash   *common.Hash    `json:"blockHash"`
+}
+
+// GetTransactionReceipt 实现 BlockReader
+func (e *EthereumClient) GetTransactionReceipt(ctx context.Context, txHash string) (*types.ReceiptResponse, error) {
+	if e.client == nil {
+		return nil, errors.New("Ethereum client not connected")
+	}
+	hash, err := parseTxHash(txHash)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := e.client.TransactionReceipt(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	fee := new(big.Int)
+	if receipt.EffectiveGasPrice != nil {
+		fee.Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
+	}
+	resp := &types.ReceiptResponse{
+		TxHash:       receipt.TxHash.Hex(),
+		BlockNumber:  receipt.BlockNumber.Uint64(),
+		BlockHash:    receipt.BlockHash.Hex(),
+		Success:      receipt.Status == ethtypes.ReceiptStatusSuccessful,
+		Fee:          fee.String(),
+		ResourceUsed: receipt.GasUsed,
+		Logs:         make([]types.LogResponse, 0, len(receipt.Logs)),
+	}
+	if receipt.ContractAddress != (common.Address{}) {
+		resp.ContractAddress = receipt.ContractAddress.Hex()
+	}
+	for _, l := range receipt.Logs {
+		topics := make([]string, 0, len(l.Topics))
+		for _, t := range l.Topics {
+			topics = append(topics, t.Hex())
+		}
+		resp.Logs = append(resp.Logs, types.LogResponse{
+			Address: l.Address.Hex(),
+			Topics:  topics,
+			Data:    hexutil.Encode(l.Data),
+		})
+	}
+	return resp, nil
+}
+
+// blockRefByNumber 實作 blockRefSource
+func (e *EthereumClient) blockRefByNumber(ctx context.Context, number uint64) (BlockRef, error) {
+	if e.client == nil {
+		return BlockRef{}, errors.New("Ethereum client not connected")
+	}
+	header, err := e.client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return BlockRef{}, err
+	}
+	return BlockRef{Number: header.Number.Uint64(), Hash: header.Hash().Hex(), ParentHash: header.ParentHash.Hex()}, nil
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// rpcHandler 依 JSON-RPC 參數回傳結果
+type rpcHandler func(params []json.RawMessage) interface{}
+
+// newFakeEVMNode 啟動測試用 JSON-RPC 節點並回傳已連線的 client，未設定的方法回應錯誤
+func newFakeEVMNode(t *testing.T, handlers map[string]rpcHandler) *EthereumClient {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if h, ok := handlers[req.Method]; ok {
+			resp["result"] = h(req.Params)
+		} else {
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found: " + req.Method}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(srv.Close)
+	c, err := ethclient.Dial(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(c.Close)
+	return &EthereumClient{client: c}
+}
+
+// opBlock OP 風格的區塊 fixture：第一筆為 deposit 交易（0x7e），第二筆為轉給 to 的一般交易
+type opBlock struct {
+	header  *ethtypes.Header
+	deposit common.Hash
+	tx      *ethtypes.Transaction
+	from    common.Address
+}
+
+func newOPBlock(t *testing.T, to common.Address) *opBlock {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := ethtypes.SignTx(ethtypes.NewTransaction(0, to, big.NewInt(1e15), 21000, big.NewInt(1), nil), ethtypes.NewEIP155Signer(big.NewInt(10)), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &opBlock{
+		header: &ethtypes.Header{
+			ParentHash: common.HexToHash("0x01"),
+			Number:     big.NewInt(100),
+			Difficulty: big.NewInt(0),
+			GasLimit:   30000000,
+			Time:       1700000000,
+			BaseFee:    big.NewInt(1),
+		},
+		deposit: common.HexToHash("0x7e"),
+		tx:      tx,
+		from:    crypto.PubkeyToAddress(key.PublicKey),
+	}
+}
+
+// result 依 fullTx 參數產生 eth_getBlockByNumber 回應
+func (b *opBlock) result(t *testing.T, fullTx bool) map[string]interface{} {
+	t.Helper()
+	data, err := json.Marshal(b.header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var block map[string]interface{}
+	if err := json.Unmarshal(data, &block); err != nil {
+		t.Fatal(err)
+	}
+	if !fullTx {
+		block["transactions"] = []string{b.deposit.Hex(), b.tx.Hash().Hex()}
+		return block
+	}
+	var tx map[string]interface{}
+	data, _ = json.Marshal(b.tx)
+	json.Unmarshal(data, &tx)
+	tx["from"] = b.from.Hex()
+	block["transactions"] = []interface{}{
+		map[string]interface{}{
+			"type":       "0x7e",
+			"hash":       b.deposit.Hex(),
+			"sourceHash": common.HexToHash("0x5e").Hex(),
+			"from":       "0xdeaddeaddeaddeaddeaddeaddeaddeaddead0001",
+			"to":         b.tx.To().Hex(),
+			"mint":       "0x0",
+			"value":      "0x1",
+			"gas":        "0xf4240",
+			"isSystemTx": false,
+			"input":      "0x",
+			"nonce":      "0x0",
+		},
+		tx,
+	}
+	return block
+}
+
+// handler 回應 eth_getBlockByNumber
+func (b *opBlock) handler(t *testing.T) rpcHandler {
+	return func(params []json.RawMessage) interface{} {
+		var fullTx bool
+		if len(params) > 1 {
+			json.Unmarshal(params[1], &fullTx)
+		}
+		return b.result(t, fullTx)
+	}
+}
+
+func TestEthereumGetBlock_OPDepositTx(t *testing.T) {
+	block := newOPBlock(t, common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"))
+	e := newFakeEVMNode(t, map[string]rpcHandler{"eth_getBlockByNumber": block.handler(t)})
+
+	got, err := e.GetBlock(context.Background(), "100")
+	if err != nil {
+		t.Fatalf("GetBlock failed: %v", err)
+	}
+	if got.Number != 100 || got.Hash != block.header.Hash().Hex() || got.TxCount != 2 {
+		t.Errorf("unexpected block %+v", got)
+	}
+	if got.Transactions[0] != block.deposit.Hex() || got.Transactions[1] != block.tx.Hash().Hex() {
+		t.Errorf("unexpected transactions %v", got.Transactions)
+	}
+}
+
+func TestEthereumGetTransaction_InvalidHash(t *testing.T) {
+	e := newFakeEVMNode(t, nil)
+	for _, hash := range []string{"0x12", "0x" + common.Bytes2Hex(make([]byte, 33)), "0xzz" + hexutil.Encode(make([]byte, 31))[2:]} {
+		if _, err := e.GetTransaction(context.Background(), hash); !errors.Is(err, ErrInvalidTxHash) {
+			t.Errorf("GetTransaction(%s): expected ErrInvalidTxHash, got %v", hash, err)
+		}
+		if _, err := e.GetTransactionReceipt(context.Background(), hash); !errors.Is(err, ErrInvalidTxHash) {
+			t.Errorf("GetTransactionReceipt(%s): expected ErrInvalidTxHash, got %v", hash, err)
+		}
+	}
+}
+
+func TestEthereumGetTransaction_OPDepositTx(t *testing.T) {
+	block := newOPBlock(t, common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"))
+	blockHash := block.header.Hash()
+	e := newFakeEVMNode(t, map[string]rpcHandler{
+		"eth_getTransactionByHash": func(params []json.RawMessage) interface{} {
+			tx := block.result(t, true)["transactions"].([]interface{})[0].(map[string]interface{})
+			tx["blockNumber"] = "0x64"
+			tx["blockHash"] = blockHash.Hex()
+			return tx
+		},
+	})
+
+	got, err := e.GetTransaction(context.Background(), block.deposit.Hex())
+	if err != nil {
+		t.Fatalf("GetTransaction failed: %v", err)
+	}
+	from := common.HexToAddress("0xdeaddeaddeaddeaddeaddeaddeaddeaddead0001").Hex()
+	if got.TxHash != block.deposit.Hex() || got.Type != "126" || got.From != from || got.To != block.tx.To().Hex() ||
+		got.Value != "1" || got.Pending || got.BlockNumber != 100 || got.BlockHash != blockHash.Hex() {
+		t.Errorf("unexpected transaction %+v", got)
+	}
+}
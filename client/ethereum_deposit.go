@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+var _ depositSource = (*EthereumClient)(nil)
+
+// scanDepositBlock 實作 depositSource
+// 主鏈幣只偵測外部交易的直接轉帳（不含合約內部轉帳），代幣以白名單合約的 Transfer 事件偵測
+// go-ethereum 不支援的交易類型（OP deposit 0x7e、Arbitrum 內部交易）略過，不影響同區塊其他交易
+func (e *EthereumClient) scanDepositBlock(ctx context.Context, number uint64, watched, tokens *watchSet) (BlockRef, []Deposit, error) {
+	if e.client == nil {
+		return BlockRef{}, nil, errors.New("Ethereum client not connected")
+	}
+	header, body, err := e.fetchBlock(ctx, blockID{number: number}, true)
+	if err != nil {
+		return BlockRef{}, nil, err
+	}
+	blockHash := header.Hash()
+	ref := BlockRef{Number: header.Number.Uint64(), Hash: blockHash.Hex(), ParentHash: header.ParentHash.Hex()}
+
+	var deposits []Deposit
+	for _, raw := range body.Transactions {
+		tx := new(ethtypes.Transaction)
+		if err := tx.UnmarshalJSON(raw); err != nil {
+			if errors.Is(err, ethtypes.ErrTxTypeNotSupported) {
+				continue
+			}
+			return BlockRef{}, nil, err
+		}
+		if tx.To() == nil || tx.Value().Sign() == 0 || !watched.contains(tx.To().Hex()) {
+			continue
+		}
+		receipt, err := e.client.TransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			return BlockRef{}, nil, err
+		}
+		if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+			continue
+		}
+		from, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return BlockRef{}, nil, err
+		}
+		deposits = append(deposits, Deposit{
+			TxHash: tx.Hash().Hex(),
+			From:   from.Hex(),
+			To:     tx.To().Hex(),
+			Amount: tx.Value().String(),
+		})
+	}
+
+	addrs, contracts := watched.list(), tokens.list()
+	if len(addrs) == 0 || len(contracts) == 0 {
+		return ref, deposits, nil
+	}
+	tokenAddrs := make([]common.Address, 0, len(contracts))
+	for _, c := range contracts {
+		tokenAddrs = append(tokenAddrs, common.HexToAddress(c))
+	}
+	toTopics := make([]common.Hash, 0, len(addrs))
+	for _, a := range addrs {
+		toTopics = append(toTopics, common.BytesToHash(common.HexToAddress(a).Bytes()))
+	}
+	logs, err := e.client.FilterLogs(ctx, ethereum.FilterQuery{
+		BlockHash: &blockHash,
+		Addresses: tokenAddrs,
+		Topics:    [][]common.Hash{{transferEventSig}, nil, toTopics},
+	})
+	if err != nil {
+		return BlockRef{}, nil, err
+	}
+	for _, l := range logs {
+		// ERC721 的 Transfer 事件有 4 個 topic，略過；節點未套用地址過濾時再次檢查白名單
+		if l.Removed || len(l.Topics) != 3 || !tokens.contains(l.Address.Hex()) {
+			continue
+		}
+		deposits = append(deposits, Deposit{
+			TxHash:   l.TxHash.Hex(),
+			LogIndex: l.Index,
+			From:     common.BytesToAddress(l.Topics[1].Bytes()).Hex(),
+			To:       common.BytesToAddress(l.Topics[2].Bytes()).Hex(),
+			Token:    l.Address.Hex(),
+			Amount:   new(big.Int).SetBytes(l.Data).String(),
+		})
+	}
+	return ref, deposits, nil
+}
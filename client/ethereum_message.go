@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var _ types.MessageSigner = (*EthereumClient)(nil)
+
+// SignMessage 實作 MessageSigner 介面，與 personal_sign 相容，不需連線節點
+func (e *EthereumClient) SignMessage(ctx context.Context, privateKey, message string) (types.MessageSignatureResponse, error) {
+	sig, pub, err := signPrefixedMessage(ethereumMessagePrefix, privateKey, message)
+	if err != nil {
+		return types.MessageSignatureResponse{}, err
+	}
+	return types.MessageSignatureResponse{Address: crypto.PubkeyToAddress(*pub).Hex(), Signature: sig}, nil
+}
+
+// VerifyMessage 實作 MessageSigner 介面，回傳 checksum 格式的簽名者地址
+func (e *EthereumClient) VerifyMessage(ctx context.Context, message, signature string) (string, error) {
+	pub, err := recoverPrefixedMessage(ethereumMessagePrefix, message, signature)
+	if err != nil {
+		return "", err
+	}
+	return crypto.PubkeyToAddress(*pub).Hex(), nil
+}
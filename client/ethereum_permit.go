@@ -0,0 +1,266 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var _ types.PermitSigner = (*EthereumClient)(nil)
+
+// ERC2612ABI EIP-2612 permit 相關方法
+const ERC2612ABI = `[
+  {"constant":true,"inputs":[],"name":"DOMAIN_SEPARATOR","outputs":[{"name":"","type":"bytes32"}],"type":"function"},
+  {"constant":true,"inputs":[{"name":"owner","type":"address"}],"name":"nonces","outputs":[{"name":"","type":"uint256"}],"type":"function"},
+  {"constant":false,"inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"},{"name":"value","type":"uint256"},{"name":"deadline","type":"uint256"},{"name":"v","type":"uint8"},{"name":"r","type":"bytes32"},{"name":"s","type":"bytes32"}],"name":"permit","outputs":[],"type":"function"},
+  {"constant":false,"inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"transferFrom","outputs":[{"name":"","type":"bool"}],"type":"function"}
+]`
+
+// permitTypeHash keccak256("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)")
+var permitTypeHash = crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+
+// transferFromGasLimit permit 上鏈前無法估算 transferFrom，使用固定上限
+const transferFromGasLimit = uint64(100000)
+
+var erc2612ABI = mustParseABI(ERC2612ABI)
+
+func mustParseABI(s string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// SignPermit 實作 PermitSigner 介面
+// 從鏈上讀取 DOMAIN_SEPARATOR 與持有人 nonce，簽署 EIP-712 Permit 結構
+func (e *EthereumClient) SignPermit(ctx context.Context, ownerPrivateKey, contractAddress, spender string, value *big.Int, deadline uint64) (types.PermitSignatureResponse, error) {
+	if e.client == nil {
+		return types.PermitSignatureResponse{}, errors.New("Ethereum client not connected")
+	}
+	if !validPermitValue(value) {
+		return types.PermitSignatureResponse{}, errors.New("invalid permit value")
+	}
+	if deadline <= uint64(time.Now().Unix()) {
+		return types.PermitSignatureResponse{}, errors.New("permit deadline has passed")
+	}
+	priv, err := crypto.HexToECDSA(ownerPrivateKey)
+	if err != nil {
+		return types.PermitSignatureResponse{}, err
+	}
+	owner := crypto.PubkeyToAddress(priv.PublicKey)
+	contract, err := parseEthereumAddress(contractAddress)
+	if err != nil {
+		return types.PermitSignatureResponse{}, err
+	}
+	spenderAddr, err := parseEthereumAddress(spender)
+	if err != nil {
+		return types.PermitSignatureResponse{}, err
+	}
+	nonce, err := e.permitNonce(ctx, contract, owner)
+	if err != nil {
+		return types.PermitSignatureResponse{}, err
+	}
+	digest, err := e.permitDigest(ctx, contract, owner, spenderAddr, value, nonce, deadline)
+	if err != nil {
+		return types.PermitSignatureResponse{}, err
+	}
+	sig, err := crypto.Sign(digest.Bytes(), priv)
+	if err != nil {
+		return types.PermitSignatureResponse{}, err
+	}
+	return types.PermitSignatureResponse{
+		ContractAddress: contract.Hex(),
+		Owner:           owner.Hex(),
+		Spender:         spenderAddr.Hex(),
+		Value:           value.String(),
+		Nonce:           nonce.String(),
+		Deadline:        deadline,
+		V:               sig[64] + 27,
+		R:               hexutil.Encode(sig[:32]),
+		S:               hexutil.Encode(sig[32:64]),
+	}, nil
+}
+
+// validPermitValue 檢查 permit 金額是否為 uint256，超過 256 位元的值打包摘要時會被截斷，簽名與回傳的 Value 不符
+func validPermitValue(value *big.Int) bool {
+	return value != nil && value.Sign() >= 0 && value.BitLen() <= 256
+}
+
+// PermitTransfer 實作 PermitSigner 介面
+// 先以目前的 nonce 驗證簽名，避免提交必定失敗的交易；permit 與 transferFrom 以連續 nonce 送出
+func (e *EthereumClient) PermitTransfer(ctx context.Context, spenderPrivateKey string, permit types.PermitSignatureResponse, toAddress string) (types.PermitTransferResponse, error) {
+	if e.client == nil {
+		return types.PermitTransferResponse{}, errors.New("Ethereum client not connected")
+	}
+	priv, err := crypto.HexToECDSA(spenderPrivateKey)
+	if err != nil {
+		return types.PermitTransferResponse{}, err
+	}
+	relayer := crypto.PubkeyToAddress(priv.PublicKey)
+	contract, err := parseEthereumAddress(permit.ContractAddress)
+	if err != nil {
+		return types.PermitTransferResponse{}, err
+	}
+	owner, err := parseEthereumAddress(permit.Owner)
+	if err != nil {
+		return types.PermitTransferResponse{}, err
+	}
+	spender, err := parseEthereumAddress(permit.Spender)
+	if err != nil {
+		return types.PermitTransferResponse{}, err
+	}
+	if spender != relayer {
+		return types.PermitTransferResponse{}, fmt.Errorf("permit spender %s does not match signer %s", spender.Hex(), relayer.Hex())
+	}
+	to, err := parseEthereumAddress(toAddress)
+	if err != nil {
+		return types.PermitTransferResponse{}, err
+	}
+	value, ok := new(big.Int).SetString(permit.Value, 10)
+	if !ok || !validPermitValue(value) {
+		return types.PermitTransferResponse{}, errors.New("invalid permit value")
+	}
+	if permit.Deadline <= uint64(time.Now().Unix()) {
+		return types.PermitTransferResponse{}, errors.New("permit deadline has passed")
+	}
+	r, err := hexutil.Decode(permit.R)
+	if err != nil || len(r) != 32 {
+		return types.PermitTransferResponse{}, errors.New("invalid permit signature r")
+	}
+	s, err := hexutil.Decode(permit.S)
+	if err != nil || len(s) != 32 {
+		return types.PermitTransferResponse{}, errors.New("invalid permit signature s")
+	}
+	if permit.V != 27 && permit.V != 28 {
+		return types.PermitTransferResponse{}, errors.New("invalid permit signature v")
+	}
+
+	nonce, err := e.permitNonce(ctx, contract, owner)
+	if err != nil {
+		return types.PermitTransferResponse{}, err
+	}
+	digest, err := e.permitDigest(ctx, contract, owner, spender, value, nonce, permit.Deadline)
+	if err != nil {
+		return types.PermitTransferResponse{}, err
+	}
+	sig := append(append(append([]byte{}, r...), s...), permit.V-27)
+	pub, err := crypto.SigToPub(digest.Bytes(), sig)
+	if err != nil {
+		return types.PermitTransferResponse{}, err
+	}
+	if crypto.PubkeyToAddress(*pub) != owner {
+		return types.PermitTransferResponse{}, errors.New("permit signature does not match owner or nonce already used")
+	}
+
+	permitData, err := erc2612ABI.Pack("permit", owner, spender, value, new(big.Int).SetUint64(permit.Deadline), permit.V, common.BytesToHash(r), common.BytesToHash(s))
+	if err != nil {
+		return types.PermitTransferResponse{}, err
+	}
+	transferData, err := erc2612ABI.Pack("transferFrom", owner, to, value)
+	if err != nil {
+		return types.PermitTransferResponse{}, err
+	}
+	permitGas, err := e.client.EstimateGas(ctx, ethereum.CallMsg{From: relayer, To: &contract, Data: permitData})
+	if err != nil {
+		return types.PermitTransferResponse{}, fmt.Errorf("permit would fail: %w", err)
+	}
+	txNonce, err := e.client.PendingNonceAt(ctx, relayer)
+	if err != nil {
+		return types.PermitTransferResponse{}, err
+	}
+	chainID, err := e.chainID(ctx)
+	if err != nil {
+		return types.PermitTransferResponse{}, err
+	}
+
+	var resp types.PermitTransferResponse
+	for i, call := range []struct {
+		data []byte
+		gas  uint64
+		hash *string
+	}{
+		{permitData, permitGas, &resp.PermitTxHash},
+		{transferData, transferFromGasLimit, &resp.TransferTxHash},
+	} {
+		tx, err := e.newTransaction(ctx, chainID, txNonce+uint64(i), contract, big.NewInt(0), call.gas, call.data)
+		if err != nil {
+			return resp, err
+		}
+		signedTx, err := ethtypes.SignTx(tx, ethtypes.LatestSignerForChainID(chainID), priv)
+		if err != nil {
+			return resp, err
+		}
+		if err := e.client.SendTransaction(ctx, signedTx); err != nil {
+			return resp, err
+		}
+		*call.hash = signedTx.Hash().Hex()
+	}
+	return resp, nil
+}
+
+// permitNonce 讀取持有人目前的 permit nonce
+func (e *EthereumClient) permitNonce(ctx context.Context, contract, owner common.Address) (*big.Int, error) {
+	out, err := e.callERC2612(ctx, contract, "nonces", owner)
+	if err != nil {
+		return nil, err
+	}
+	nonce, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, errors.New("unexpected nonces result")
+	}
+	return nonce, nil
+}
+
+// permitDigest 計算 EIP-712 摘要 keccak256(0x1901 ‖ DOMAIN_SEPARATOR ‖ hashStruct(Permit))
+func (e *EthereumClient) permitDigest(ctx context.Context, contract, owner, spender common.Address, value, nonce *big.Int, deadline uint64) (common.Hash, error) {
+	out, err := e.callERC2612(ctx, contract, "DOMAIN_SEPARATOR")
+	if err != nil {
+		return common.Hash{}, err
+	}
+	domain, ok := out[0].([32]byte)
+	if !ok {
+		return common.Hash{}, errors.New("unexpected DOMAIN_SEPARATOR result")
+	}
+	return permitStructDigest(domain, owner, spender, value, nonce, deadline), nil
+}
+
+// permitStructDigest 依網域分隔值計算 Permit 結構的 EIP-712 摘要
+func permitStructDigest(domain [32]byte, owner, spender common.Address, value, nonce *big.Int, deadline uint64) common.Hash {
+	structHash := crypto.Keccak256(
+		permitTypeHash.Bytes(),
+		common.LeftPadBytes(owner.Bytes(), 32),
+		common.LeftPadBytes(spender.Bytes(), 32),
+		common.LeftPadBytes(value.Bytes(), 32),
+		common.LeftPadBytes(nonce.Bytes(), 32),
+		common.LeftPadBytes(new(big.Int).SetUint64(deadline).Bytes(), 32),
+	)
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domain[:], structHash)
+}
+
+// callERC2612 呼叫 permit 相關的唯讀方法，合約未實作時回傳明確錯誤
+func (e *EthereumClient) callERC2612(ctx context.Context, contract common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := erc2612ABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	result, err := e.client.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("token %s does not support EIP-2612 (%s)", contract.Hex(), method)
+	}
+	return erc2612ABI.Unpack(method, result)
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+func TestPermitStructDigest(t *testing.T) {
+	owner := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	spender := common.HexToAddress("0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359")
+	value, _ := new(big.Int).SetString("1000000000000000000", 10)
+	nonce := big.NewInt(3)
+	deadline := uint64(1900000000)
+
+	typed := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Permit": {
+				{Name: "owner", Type: "address"},
+				{Name: "spender", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Permit",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "USD Coin",
+			Version:           "2",
+			ChainId:           math.NewHexOrDecimal256(1),
+			VerifyingContract: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+		},
+		Message: apitypes.TypedDataMessage{
+			"owner":    owner.Hex(),
+			"spender":  spender.Hex(),
+			"value":    value.String(),
+			"nonce":    nonce.String(),
+			"deadline": new(big.Int).SetUint64(deadline).String(),
+		},
+	}
+	want, _, err := apitypes.TypedDataAndHash(typed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain, err := typed.HashStruct("EIP712Domain", typed.Domain.Map())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sep [32]byte
+	copy(sep[:], domain)
+	if got := permitStructDigest(sep, owner, spender, value, nonce, deadline); got != common.BytesToHash(want) {
+		t.Errorf("digest mismatch: got %s, want %x", got.Hex(), want)
+	}
+}
+
+func TestValidPermitValue(t *testing.T) {
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	for _, tt := range []struct {
+		value *big.Int
+		want  bool
+	}{
+		{big.NewInt(0), true},
+		{maxUint256, true},
+		{new(big.Int).Add(maxUint256, big.NewInt(1)), false},
+		{big.NewInt(-1), false},
+		{nil, false},
+	} {
+		if got := validPermitValue(tt.value); got != tt.want {
+			t.Errorf("validPermitValue(%v) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
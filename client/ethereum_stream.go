@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var _ types.BlockSubscriber = (*EthereumClient)(nil)
+
+// SubscribeNewBlocks 实现 BlockSubscriber，透過 eth_subscribe newHeads 訂閱新區塊
+func (e *EthereumClient) SubscribeNewBlocks(ctx context.Context) (<-chan types.BlockHeaderResponse, error) {
+	if e.client == nil {
+		return nil, errors.New("Ethereum client not connected")
+	}
+	heads := make(chan *ethtypes.Header, streamBufferSize)
+	sub, err := e.client.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return nil, subscriptionError(err)
+	}
+	out := make(chan types.BlockHeaderResponse, streamBufferSize)
+	go func() {
+		defer close(out)
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sub.Err():
+				return
+			case h := <-heads:
+				trySend(out, types.BlockHeaderResponse{
+					Number:     h.Number.Uint64(),
+					Hash:       h.Hash().Hex(),
+					ParentHash: h.ParentHash.Hex(),
+					Timestamp:  int64(h.Time),
+				})
+			}
+		}
+	}()
+	return out, nil
+}
+
+// SubscribePendingTransactions 实现 BlockSubscriber，透過 eth_subscribe newPendingTransactions 訂閱交易雜湊
+func (e *EthereumClient) SubscribePendingTransactions(ctx context.Context) (<-chan string, error) {
+	if e.client == nil {
+		return nil, errors.New("Ethereum client not connected")
+	}
+	hashes := make(chan common.Hash, streamBufferSize)
+	sub, err := e.client.Client().EthSubscribe(ctx, hashes, "newPendingTransactions")
+	if err != nil {
+		return nil, subscriptionError(err)
+	}
+	out := make(chan string, streamBufferSize)
+	go func() {
+		defer close(out)
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sub.Err():
+				return
+			case h := <-hashes:
+				trySend(out, h.Hex())
+			}
+		}
+	}()
+	return out, nil
+}
+
+// subscriptionError 補充 HTTP 節點不支援訂閱時的說明
+func subscriptionError(err error) error {
+	if errors.Is(err, rpc.ErrNotificationsUnsupported) {
+		return fmt.Errorf("%w: connect with a websocket (ws:// or wss://) or IPC endpoint", err)
+	}
+	return err
+}
@@ -1,6 +1,11 @@
 package client
 
 import (
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
 	"github.com/blockchain-sdk-go/api/types"
 )
 
@@ -12,18 +17,100 @@ const (
 	Tron     BlockchainType = "tron"     // 波場
 )
 
+// Chain 已註冊的區塊鏈實作
+// Type：NewBlockchainClient 使用的名稱，亦為預設的 API 路由前綴
+// Capabilities：實作提供的功能，由 /chains 回報，須與 client 的 Capabilities 一致
+// NodeURLEnv：自動連線使用的節點位址環境變數，空值時不自動連線
+// New：建立 client，設定取自環境變數
+// Instances：建立 API 使用的實例，同一實作服務多個網路時提供（如 EVM 每個網路一個實例），未提供時以 New 建立單一實例
+type Chain struct {
+	Type         BlockchainType
+	Description  string
+	Capabilities types.CapabilitySet
+	NodeURLEnv   string
+	New          func() (types.BlockchainClient, error)
+	Instances    func() ([]Instance, error)
+}
+
+// Instance 區塊鏈實作在 API 中的單一實例，每個實例一個路由群組
+// Name：路由前綴
+// Client：尚未連線的 client
+// NodeURL：自動連線的節點位址，空值時由 client 使用自身設定
+// AutoConnect：啟動時是否自動連線
+type Instance struct {
+	Name        string
+	Client      types.BlockchainClient
+	NodeURL     string
+	AutoConnect bool
+}
+
+// NewInstances 建立區塊鏈實作在 API 中的實例
+// 未提供 Instances 時建立單一實例，名稱為 Type，節點位址取自 NodeURLEnv，有設定時自動連線
+func (c Chain) NewInstances() ([]Instance, error) {
+	if c.Instances != nil {
+		return c.Instances()
+	}
+	cl, err := c.New()
+	if err != nil {
+		return nil, err
+	}
+	var nodeURL string
+	if c.NodeURLEnv != "" {
+		nodeURL = os.Getenv(c.NodeURLEnv)
+	}
+	return []Instance{{Name: string(c.Type), Client: cl, NodeURL: nodeURL, AutoConnect: nodeURL != ""}}, nil
+}
+
+var (
+	chainsMu sync.RWMutex
+	chains   = map[BlockchainType]Chain{}
+)
+
+// Register 註冊區塊鏈實作，供各實作套件在 init 中呼叫
+// 新增區塊鏈時以獨立套件實作 BlockchainClient 並註冊，由 cmd/api 匿名 import 即可產生路由；
+// EVM 相容鏈可在 Instances 中以 NewEVMClient 建立自己的網路
+// 名稱重複或未提供建構函式時 panic
+func Register(chain Chain) {
+	chainsMu.Lock()
+	defer chainsMu.Unlock()
+	if chain.Type == "" || chain.New == nil {
+		panic("client: Register requires a chain type and constructor")
+	}
+	if _, dup := chains[chain.Type]; dup {
+		panic(fmt.Sprintf("client: chain %q registered twice", chain.Type))
+	}
+	chains[chain.Type] = chain
+}
+
+// LookupChain 依類型查詢已註冊的區塊鏈實作
+func LookupChain(blockchainType BlockchainType) (Chain, bool) {
+	chainsMu.RLock()
+	defer chainsMu.RUnlock()
+	chain, ok := chains[blockchainType]
+	return chain, ok
+}
+
+// Chains 依類型名稱排序列出已註冊的區塊鏈實作
+func Chains() []Chain {
+	chainsMu.RLock()
+	defer chainsMu.RUnlock()
+	out := make([]Chain, 0, len(chains))
+	for _, chain := range chains {
+		out = append(out, chain)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Type < out[j].Type })
+	return out
+}
+
 // NewBlockchainClient 根據區塊鏈類型建立對應 client
 // blockchainType：區塊鏈類型
-// 回傳 BlockchainClient 與錯誤
+// 回傳 BlockchainClient 與錯誤，未註冊的類型回傳 ErrUnsupportedBlockchain
 func NewBlockchainClient(blockchainType BlockchainType) (types.BlockchainClient, error) {
-	switch blockchainType {
-	case Ethereum:
-		return NewEthereumClient()
-	case Tron:
-		return NewTronClient()
-	default:
+	chain, ok := LookupChain(blockchainType)
+	if !ok {
 		return nil, ErrUnsupportedBlockchain
 	}
+	return chain.New()
 }
 
 // NewEthereumClient 建立以太坊 client
@@ -31,7 +118,48 @@ func NewEthereumClient() (types.BlockchainClient, error) {
 	return &EthereumClient{}, nil
 }
 
-// NewTronClient 建立波場 client
+// NewEVMClient 建立指定 EVM 網路的 client
+func NewEVMClient(network Network) (types.BlockchainClient, error) {
+	return &EthereumClient{network: network}, nil
+}
+
+// NewTronClient 建立波場 client，使用預設設定
 func NewTronClient() (types.BlockchainClient, error) {
-	return &TronClient{}, nil
+	return NewTronClientWithConfig(TronConfig{})
+}
+
+// NewTronClientWithConfig 建立指定設定的波場 client
+func NewTronClientWithConfig(cfg TronConfig) (types.BlockchainClient, error) {
+	if cfg.FeeLimit <= 0 {
+		cfg.FeeLimit = DefaultTronFeeLimit
+	}
+	return &TronClient{config: cfg}, nil
+}
+
+// newTronClientFromEnv 以環境變數設定建立波場 client，供註冊表使用
+func newTronClientFromEnv() (types.BlockchainClient, error) {
+	cfg, err := TronConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return NewTronClientWithConfig(cfg)
+}
+
+// evmInstances 每個 EVM 網路建立一個實例，網路設定取自 EVM_NETWORKS_FILE 與內建網路，
+// RPC 位址由 {NAME}_NODE_URL 環境變數覆寫，有 RPC 位址的網路自動連線
+func evmInstances() ([]Instance, error) {
+	networks, err := LoadNetworkRegistry(os.Getenv("EVM_NETWORKS_FILE"))
+	if err != nil {
+		return nil, err
+	}
+	list := networks.List()
+	instances := make([]Instance, 0, len(list))
+	for _, network := range list {
+		c, err := NewEVMClient(network)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, Instance{Name: network.Name, Client: c, AutoConnect: len(network.RPCURLs) > 0})
+	}
+	return instances, nil
 }
@@ -2,6 +2,8 @@ package client
 
 import (
 	"testing"
+
+	"github.com/blockchain-sdk-go/api/types"
 )
 
 func TestNewBlockchainClient(t *testing.T) {
@@ -18,3 +20,87 @@ func TestNewBlockchainClient(t *testing.T) {
 		t.Error("expected error for unknown blockchain, got nil")
 	}
 }
+
+func TestChainRegistry(t *testing.T) {
+	registered := Chains()
+	if len(registered) < 2 || registered[0].Type != Ethereum || registered[1].Type != Tron {
+		t.Fatalf("expected built-in chains sorted by type, got %+v", registered)
+	}
+	tron, ok := LookupChain(Tron)
+	if !ok || tron.NodeURLEnv != "TRON_NODE_URL" || len(tron.Capabilities) == 0 {
+		t.Errorf("unexpected Tron registration %+v", tron)
+	}
+	// 註冊的功能須與 client 宣告的一致，路由與 /capabilities 才不會不同步
+	for _, chain := range registered {
+		c, err := chain.New()
+		if err != nil {
+			t.Fatalf("%s: %v", chain.Type, err)
+		}
+		provider, ok := c.(types.CapabilityProvider)
+		if !ok || len(provider.Capabilities()) != len(chain.Capabilities) {
+			t.Errorf("%s: client capabilities differ from registration", chain.Type)
+			continue
+		}
+		for _, capability := range chain.Capabilities {
+			if !provider.Capabilities().Has(capability) {
+				t.Errorf("%s: client does not declare %q", chain.Type, capability)
+			}
+		}
+	}
+
+	Register(Chain{Type: "test-chain", New: NewEthereumClient})
+	defer func() {
+		chainsMu.Lock()
+		delete(chains, "test-chain")
+		chainsMu.Unlock()
+	}()
+	if _, err := NewBlockchainClient("test-chain"); err != nil {
+		t.Errorf("expected registered chain to be constructed, got %v", err)
+	}
+	for name, chain := range map[string]Chain{
+		"duplicate": {Type: "test-chain", New: NewEthereumClient},
+		"no type":   {New: NewEthereumClient},
+		"no ctor":   {Type: "other"},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected Register to panic", name)
+				}
+			}()
+			Register(chain)
+		}()
+	}
+}
+
+func TestChainNewInstances(t *testing.T) {
+	t.Setenv("EVM_NETWORKS_FILE", "")
+	t.Setenv("BSC_NODE_URL", "http://127.0.0.1:8545")
+	t.Setenv("TRON_NODE_URL", "grpc.example.com:50051")
+
+	// EVM 依網路設定展開，每個網路一個實例
+	eth, _ := LookupChain(Ethereum)
+	instances, err := eth.NewInstances()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(instances) != len(DefaultNetworks) {
+		t.Fatalf("expected %d EVM instances, got %d", len(DefaultNetworks), len(instances))
+	}
+	for _, instance := range instances {
+		info := instance.Client.(types.NetworkInfoProvider).NetworkInfo()
+		if info.Name != instance.Name || instance.NodeURL != "" || instance.AutoConnect != (instance.Name == "bsc") {
+			t.Errorf("unexpected EVM instance %+v", instance)
+		}
+	}
+
+	// 未提供 Instances 時以 New 建立單一實例，節點位址取自 NodeURLEnv
+	tron, _ := LookupChain(Tron)
+	instances, err = tron.NewInstances()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(instances) != 1 || instances[0].Name != "tron" || instances[0].NodeURL != "grpc.example.com:50051" || !instances[0].AutoConnect {
+		t.Errorf("unexpected Tron instances %+v", instances)
+	}
+}
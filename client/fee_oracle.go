@@ -0,0 +1,336 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/ethereum/go-ethereum"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// FeeTier 手續費檔位
+type FeeTier string
+
+const (
+	FeeTierSlow     FeeTier = "slow"
+	FeeTierStandard FeeTier = "standard"
+	FeeTierFast     FeeTier = "fast"
+)
+
+// feeTiers 檔位與取樣的 eth_feeHistory 小費百分位
+var feeTiers = []struct {
+	tier       FeeTier
+	percentile float64
+}{
+	{FeeTierSlow, 10},
+	{FeeTierStandard, 50},
+	{FeeTierFast, 90},
+}
+
+// FeeConfig 手續費預言機設定
+// BlockCount：eth_feeHistory 取樣區塊數，預設 20
+// MinPriorityFeeGwei：小費（legacy 為 gasPrice）下限
+// MaxFeeGwei：maxFeePerGas（legacy 為 gasPrice）上限
+// Tier：寫入交易使用的檔位，預設 standard
+type FeeConfig struct {
+	BlockCount         uint64  `json:"block_count,omitempty"`           // 取樣區塊數
+	MinPriorityFeeGwei float64 `json:"min_priority_fee_gwei,omitempty"` // 小費下限（Gwei）
+	MaxFeeGwei         float64 `json:"max_fee_gwei,omitempty"`          // 手續費上限（Gwei）
+	Tier               FeeTier `json:"tier,omitempty"`                  // 寫入交易使用的檔位
+}
+
+// FeeSuggestion 單一檔位的手續費建議
+// EIP-1559 網路使用 TipCap/FeeCap，legacy 網路使用 GasPrice
+type FeeSuggestion struct {
+	Tier             FeeTier
+	TipCap           *big.Int
+	FeeCap           *big.Int
+	GasPrice         *big.Int
+	EstimatedSeconds uint64
+}
+
+// FeeEstimate 某一區塊的手續費建議
+type FeeEstimate struct {
+	BlockNumber uint64
+	BaseFee     *big.Int // 下一區塊 base fee，legacy 網路可能為 0
+	BlockTime   time.Duration
+	Tiers       []FeeSuggestion
+}
+
+// Tier 取得指定檔位，未知檔位回傳 standard
+func (f *FeeEstimate) Tier(tier FeeTier) FeeSuggestion {
+	for _, s := range f.Tiers {
+		if s.Tier == tier {
+			return s
+		}
+	}
+	for _, s := range f.Tiers {
+		if s.Tier == FeeTierStandard {
+			return s
+		}
+	}
+	return FeeSuggestion{}
+}
+
+// feeHistoryBackend 預言機所需的節點操作，*ethclient.Client 已實作
+type feeHistoryBackend interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error)
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+}
+
+// FeeOracle 以 eth_feeHistory 小費百分位提供 slow、standard、fast 三檔手續費，結果依區塊快取
+type FeeOracle struct {
+	backend  feeHistoryBackend
+	cfg      FeeConfig
+	feeModel FeeModel
+
+	mu     sync.Mutex
+	cached *FeeEstimate
+}
+
+// NewFeeOracle 建立手續費預言機，client 須已連線
+func NewFeeOracle(c *EthereumClient) (*FeeOracle, error) {
+	if c.client == nil {
+		return nil, errors.New("Ethereum client not connected")
+	}
+	return newFeeOracle(c.client, c.network), nil
+}
+
+func newFeeOracle(backend feeHistoryBackend, network Network) *FeeOracle {
+	cfg := network.Fees
+	if cfg.BlockCount == 0 {
+		cfg.BlockCount = 20
+	}
+	if cfg.Tier == "" {
+		cfg.Tier = FeeTierStandard
+	}
+	return &FeeOracle{backend: backend, cfg: cfg, feeModel: network.FeeModel}
+}
+
+// Estimate 取得最新區塊的手續費建議，同一區塊內重複呼叫使用快取
+// 鎖只保護快取讀寫，RPC 呼叫期間不持有鎖，避免慢節點阻塞其他請求
+func (o *FeeOracle) Estimate(ctx context.Context) (*FeeEstimate, error) {
+	head, err := o.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	number := head.Number.Uint64()
+
+	o.mu.Lock()
+	cached := o.cached
+	o.mu.Unlock()
+	if cached != nil && cached.BlockNumber == number {
+		return cached, nil
+	}
+
+	estimate, err := o.estimate(ctx, head)
+	if err != nil {
+		return nil, err
+	}
+	o.mu.Lock()
+	// 並行請求可能已快取較新的區塊，不以舊結果覆蓋
+	if o.cached == nil || o.cached.BlockNumber <= number {
+		o.cached = estimate
+	}
+	o.mu.Unlock()
+	return estimate, nil
+}
+
+// Suggest 取得設定檔位的手續費建議，供寫入交易使用
+func (o *FeeOracle) Suggest(ctx context.Context) (FeeSuggestion, error) {
+	estimate, err := o.Estimate(ctx)
+	if err != nil {
+		return FeeSuggestion{}, err
+	}
+	return estimate.Tier(o.cfg.Tier), nil
+}
+
+// estimate 取樣 eth_feeHistory 計算各檔位
+// 每檔小費取各區塊對應百分位的中位數；預估打包時間以「小費不低於區塊 10 百分位」的區塊比例推算
+func (o *FeeOracle) estimate(ctx context.Context, head *ethtypes.Header) (*FeeEstimate, error) {
+	percentiles := make([]float64, len(feeTiers))
+	for i, t := range feeTiers {
+		percentiles[i] = t.percentile
+	}
+	history, err := o.backend.FeeHistory(ctx, o.cfg.BlockCount, head.Number, percentiles)
+	if err != nil {
+		if o.feeModel == FeeModelEIP1559 {
+			return nil, err
+		}
+		// 部分 legacy 網路不支援 eth_feeHistory，退回單一 gasPrice
+		return o.fallback(ctx, head)
+	}
+
+	estimate := &FeeEstimate{
+		BlockNumber: head.Number.Uint64(),
+		BaseFee:     new(big.Int),
+		BlockTime:   o.blockTime(ctx, head, history),
+	}
+	if n := len(history.BaseFee); n > 0 && history.BaseFee[n-1] != nil {
+		estimate.BaseFee = history.BaseFee[n-1]
+	}
+
+	// 只取有交易的區塊，空區塊的小費為 0 會拉低建議值
+	var rewards [][]*big.Int
+	for i, r := range history.Reward {
+		if len(r) == len(feeTiers) && i < len(history.GasUsedRatio) && history.GasUsedRatio[i] > 0 {
+			rewards = append(rewards, r)
+		}
+	}
+	if len(rewards) == 0 {
+		return o.fallback(ctx, head)
+	}
+
+	for i, t := range feeTiers {
+		samples := make([]*big.Int, len(rewards))
+		for j, r := range rewards {
+			samples[j] = r[i]
+		}
+		tip := median(samples)
+		blocks := expectedBlocks(tip, rewards, o.cfg.BlockCount)
+		estimate.Tiers = append(estimate.Tiers, o.suggestion(t.tier, tip, estimate.BaseFee, blocks, estimate.BlockTime))
+	}
+	return estimate, nil
+}
+
+// fallback 以 eth_gasPrice 作為所有檔位的建議
+func (o *FeeOracle) fallback(ctx context.Context, head *ethtypes.Header) (*FeeEstimate, error) {
+	gasPrice, err := o.backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	baseFee := new(big.Int)
+	if head.BaseFee != nil {
+		baseFee = head.BaseFee
+	}
+	tip := new(big.Int).Sub(gasPrice, baseFee)
+	if tip.Sign() < 0 {
+		tip.SetInt64(0)
+	}
+	estimate := &FeeEstimate{BlockNumber: head.Number.Uint64(), BaseFee: baseFee}
+	for _, t := range feeTiers {
+		estimate.Tiers = append(estimate.Tiers, o.suggestion(t.tier, tip, baseFee, 1, 0))
+	}
+	return estimate, nil
+}
+
+// suggestion 依手續費模型組出建議並套用上下限
+func (o *FeeOracle) suggestion(tier FeeTier, tip, baseFee *big.Int, blocks uint64, blockTime time.Duration) FeeSuggestion {
+	tip = new(big.Int).Set(tip)
+	if min := gweiToWei(o.cfg.MinPriorityFeeGwei); min != nil && tip.Cmp(min) < 0 {
+		tip.Set(min)
+	}
+	max := gweiToWei(o.cfg.MaxFeeGwei)
+	s := FeeSuggestion{Tier: tier, EstimatedSeconds: uint64(math.Ceil(float64(blocks) * blockTime.Seconds()))}
+	if o.feeModel == FeeModelEIP1559 {
+		feeCap := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tip)
+		if max != nil && feeCap.Cmp(max) > 0 {
+			feeCap.Set(max)
+		}
+		if tip.Cmp(feeCap) > 0 {
+			tip.Set(feeCap)
+		}
+		s.TipCap, s.FeeCap = tip, feeCap
+		return s
+	}
+	gasPrice := new(big.Int).Add(baseFee, tip)
+	if max != nil && gasPrice.Cmp(max) > 0 {
+		gasPrice.Set(max)
+	}
+	s.GasPrice = gasPrice
+	return s
+}
+
+// blockTime 以取樣區間首尾區塊時間推算平均出塊時間
+func (o *FeeOracle) blockTime(ctx context.Context, head *ethtypes.Header, history *ethereum.FeeHistory) time.Duration {
+	if history.OldestBlock == nil || history.OldestBlock.Cmp(head.Number) >= 0 {
+		return 0
+	}
+	oldest, err := o.backend.HeaderByNumber(ctx, history.OldestBlock)
+	if err != nil || head.Time <= oldest.Time {
+		return 0
+	}
+	blocks := new(big.Int).Sub(head.Number, history.OldestBlock).Uint64()
+	return time.Duration(head.Time-oldest.Time) * time.Second / time.Duration(blocks)
+}
+
+// GetGasFees 實作 GasFeeOracle 介面
+func (e *EthereumClient) GetGasFees(ctx context.Context) (types.GasFeesResponse, error) {
+	oracle, err := e.feeOracle()
+	if err != nil {
+		return types.GasFeesResponse{}, err
+	}
+	estimate, err := oracle.Estimate(ctx)
+	if err != nil {
+		return types.GasFeesResponse{}, err
+	}
+	resp := types.GasFeesResponse{
+		BlockNumber: estimate.BlockNumber,
+		FeeModel:    string(oracle.feeModel),
+		BlockTime:   estimate.BlockTime.Seconds(),
+	}
+	if oracle.feeModel == FeeModelEIP1559 {
+		resp.BaseFee = estimate.BaseFee.String()
+	}
+	for _, s := range estimate.Tiers {
+		tier := types.GasFeeTierResponse{Tier: string(s.Tier), EstimatedSeconds: s.EstimatedSeconds}
+		if s.GasPrice != nil {
+			tier.GasPrice = s.GasPrice.String()
+		} else {
+			tier.MaxPriorityFeePerGas = s.TipCap.String()
+			tier.MaxFeePerGas = s.FeeCap.String()
+		}
+		resp.Tiers = append(resp.Tiers, tier)
+	}
+	return resp, nil
+}
+
+// feeOracle 取得連線對應的手續費預言機
+func (e *EthereumClient) feeOracle() (*FeeOracle, error) {
+	if e.client == nil || e.oracle == nil {
+		return nil, errors.New("Ethereum client not connected")
+	}
+	return e.oracle, nil
+}
+
+// median 回傳中位數（偶數個取較低者）
+func median(values []*big.Int) *big.Int {
+	sorted := append([]*big.Int(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+	return new(big.Int).Set(sorted[(len(sorted)-1)/2])
+}
+
+// expectedBlocks 以小費不低於區塊 10 百分位小費的區塊比例估算需等待的區塊數
+func expectedBlocks(tip *big.Int, rewards [][]*big.Int, max uint64) uint64 {
+	included := 0
+	for _, r := range rewards {
+		if tip.Cmp(r[0]) >= 0 {
+			included++
+		}
+	}
+	if included == 0 {
+		return max
+	}
+	blocks := uint64(math.Ceil(float64(len(rewards)) / float64(included)))
+	if blocks > max {
+		return max
+	}
+	return blocks
+}
+
+// gweiToWei 轉換 Gwei 設定值，0 表示未設定
+func gweiToWei(gwei float64) *big.Int {
+	if gwei <= 0 {
+		return nil
+	}
+	wei, _ := new(big.Float).Mul(big.NewFloat(gwei), big.NewFloat(params.GWei)).Int(nil)
+	return wei
+}
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// fakeFeeBackend 測試用節點，最新區塊 100，出塊間隔 12 秒
+type fakeFeeBackend struct {
+	head    uint64
+	calls   int
+	history *ethereum.FeeHistory
+}
+
+func (f *fakeFeeBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	n := f.head
+	if number != nil {
+		n = number.Uint64()
+	}
+	return &ethtypes.Header{Number: new(big.Int).SetUint64(n), Time: n * 12, BaseFee: big.NewInt(params.GWei)}, nil
+}
+
+func (f *fakeFeeBackend) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	f.calls++
+	return f.history, nil
+}
+
+func (f *fakeFeeBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(5 * params.GWei), nil
+}
+
+func gwei(n int64) *big.Int {
+	return big.NewInt(n * params.GWei)
+}
+
+func newFakeFeeBackend() *fakeFeeBackend {
+	return &fakeFeeBackend{head: 100, history: &ethereum.FeeHistory{
+		OldestBlock: big.NewInt(97),
+		Reward: [][]*big.Int{
+			{gwei(1), gwei(2), gwei(5)},
+			{gwei(0), gwei(0), gwei(0)}, // 空區塊，不納入計算
+			{gwei(1), gwei(3), gwei(6)},
+			{gwei(2), gwei(4), gwei(7)},
+		},
+		BaseFee:      []*big.Int{gwei(10), gwei(10), gwei(10), gwei(10), gwei(20)},
+		GasUsedRatio: []float64{0.5, 0, 0.6, 0.7},
+	}}
+}
+
+func TestFeeOracle_EIP1559(t *testing.T) {
+	backend := newFakeFeeBackend()
+	oracle := newFeeOracle(backend, Network{FeeModel: FeeModelEIP1559})
+	estimate, err := oracle.Estimate(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if estimate.BaseFee.Cmp(gwei(20)) != 0 || estimate.BlockTime.Seconds() != 12 {
+		t.Errorf("unexpected base fee %s or block time %s", estimate.BaseFee, estimate.BlockTime)
+	}
+	want := map[FeeTier]struct {
+		tip     int64
+		seconds uint64
+	}{
+		FeeTierSlow:     {1, 24}, // 1 Gwei 只在 2/3 的區塊不低於 10 百分位
+		FeeTierStandard: {3, 12},
+		FeeTierFast:     {6, 12},
+	}
+	for tier, w := range want {
+		s := estimate.Tier(tier)
+		if s.TipCap.Cmp(gwei(w.tip)) != 0 || s.FeeCap.Cmp(gwei(40+w.tip)) != 0 || s.EstimatedSeconds != w.seconds {
+			t.Errorf("%s: unexpected suggestion %+v", tier, s)
+		}
+	}
+
+	// 同一區塊使用快取
+	if _, err := oracle.Estimate(context.Background()); err != nil || backend.calls != 1 {
+		t.Errorf("expected cached estimate, got %d fee history calls", backend.calls)
+	}
+	backend.head++
+	if _, err := oracle.Estimate(context.Background()); err != nil || backend.calls != 2 {
+		t.Errorf("expected refresh on new block, got %d fee history calls", backend.calls)
+	}
+}
+
+// blockingFeeBackend FeeHistory 在 release 關閉前阻塞，用於確認 RPC 期間未持有鎖
+type blockingFeeBackend struct {
+	*fakeFeeBackend
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingFeeBackend) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	b.entered <- struct{}{}
+	<-b.release
+	return b.history, nil
+}
+
+func TestFeeOracle_ConcurrentEstimate(t *testing.T) {
+	backend := &blockingFeeBackend{fakeFeeBackend: newFakeFeeBackend(), entered: make(chan struct{}), release: make(chan struct{})}
+	oracle := newFeeOracle(backend, Network{FeeModel: FeeModelEIP1559})
+
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := oracle.Estimate(context.Background())
+			errs <- err
+		}()
+	}
+	// 兩個請求須能同時進行 RPC
+	for i := 0; i < 2; i++ {
+		select {
+		case <-backend.entered:
+		case <-time.After(2 * time.Second):
+			t.Fatal("estimate blocked while another request was waiting on the node")
+		}
+	}
+	close(backend.release)
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if oracle.cached == nil || oracle.cached.BlockNumber != 100 {
+		t.Errorf("expected cached estimate for block 100, got %+v", oracle.cached)
+	}
+}
+
+func TestFeeOracle_Caps(t *testing.T) {
+	oracle := newFeeOracle(newFakeFeeBackend(), Network{
+		FeeModel: FeeModelLegacy,
+		Fees:     FeeConfig{MinPriorityFeeGwei: 2, MaxFeeGwei: 24.5, Tier: FeeTierFast},
+	})
+	estimate, err := oracle.Estimate(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := estimate.Tier(FeeTierSlow); s.GasPrice.Cmp(gwei(22)) != 0 {
+		t.Errorf("slow gas price should be raised to the minimum tip: %s", s.GasPrice)
+	}
+	s, err := oracle.Suggest(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Tier != FeeTierFast || s.GasPrice.Cmp(big.NewInt(24500000000)) != 0 || s.TipCap != nil {
+		t.Errorf("fast gas price should be capped: %+v", s)
+	}
+}
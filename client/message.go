@@ -0,0 +1,56 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// 訊息簽名前綴，簽名對象為 keccak256(前綴 + 訊息長度 + 訊息)
+const (
+	ethereumMessagePrefix = "\x19Ethereum Signed Message:\n" // EIP-191 personal_sign
+	tronMessagePrefix     = "\x19TRON Signed Message:\n"     // TIP-191 signMessageV2
+)
+
+// prefixedMessageHash 計算帶前綴的訊息雜湊
+func prefixedMessageHash(prefix, message string) []byte {
+	return crypto.Keccak256([]byte(prefix + strconv.Itoa(len(message)) + message))
+}
+
+// signPrefixedMessage 以私鑰簽署訊息，回傳 v 為 27 或 28 的 0x 開頭簽名與簽名者公鑰
+func signPrefixedMessage(prefix, privateKey, message string) (string, *ecdsa.PublicKey, error) {
+	key, err := crypto.HexToECDSA(privateKey)
+	if err != nil {
+		return "", nil, err
+	}
+	sig, err := crypto.Sign(prefixedMessageHash(prefix, message), key)
+	if err != nil {
+		return "", nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return hexutil.Encode(sig), &key.PublicKey, nil
+}
+
+// recoverPrefixedMessage 由簽名還原簽名者公鑰，v 接受 0、1 或 27、28
+func recoverPrefixedMessage(prefix, message, signature string) (*ecdsa.PublicKey, error) {
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		if sig, err = hexutil.Decode("0x" + signature); err != nil {
+			return nil, fmt.Errorf("invalid signature: %w", err)
+		}
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length %d, expected %d", len(sig), crypto.SignatureLength)
+	}
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	if sig[crypto.RecoveryIDOffset] > 1 {
+		return nil, errors.New("invalid signature recovery id")
+	}
+	return crypto.SigToPub(prefixedMessageHash(prefix, message), sig)
+}
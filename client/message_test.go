@@ -0,0 +1,87 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+)
+
+// web3.js accounts.sign 文件範例
+const (
+	testMessageKey       = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	testMessageSignature = "0xb91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c"
+)
+
+func TestEthereumClient_SignMessage(t *testing.T) {
+	if !bytes.Equal(prefixedMessageHash(ethereumMessagePrefix, "Some data"), accounts.TextHash([]byte("Some data"))) {
+		t.Fatal("message hash does not match EIP-191")
+	}
+	e := &EthereumClient{}
+	sig, err := e.SignMessage(context.Background(), testMessageKey, "Some data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig.Signature != testMessageSignature {
+		t.Errorf("expected signature %s, got %s", testMessageSignature, sig.Signature)
+	}
+	signer, err := e.VerifyMessage(context.Background(), "Some data", sig.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer != sig.Address || signer != "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23" {
+		t.Errorf("unexpected signer %s", signer)
+	}
+}
+
+func TestTronClient_SignMessage(t *testing.T) {
+	key, err := crypto.HexToECDSA(testMessageKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := address.PubkeyToAddress(key.PublicKey).String()
+	tr := &TronClient{}
+	message := "登入 dApp nonce=42"
+
+	sig, err := tr.SignMessage(context.Background(), testMessageKey, message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig.Address != want || !strings.HasPrefix(sig.Signature, "0x") || len(sig.Signature) != 132 {
+		t.Fatalf("unexpected signature %+v", sig)
+	}
+	if v := sig.Signature[130:]; v != "1b" && v != "1c" {
+		t.Errorf("expected v of 27 or 28, got %s", v)
+	}
+
+	// 不帶 0x 與 v 為 0/1 的簽名同樣可驗證
+	raw := strings.TrimPrefix(sig.Signature, "0x")
+	v := "00"
+	if raw[128:] == "1c" {
+		v = "01"
+	}
+	for _, s := range []string{sig.Signature, raw, raw[:128] + v} {
+		signer, err := tr.VerifyMessage(context.Background(), message, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if signer != want {
+			t.Errorf("expected signer %s, got %s", want, signer)
+		}
+	}
+
+	// 與 EIP-191 前綴不同，同一簽名不會還原出相同地址
+	if signer, err := tr.VerifyMessage(context.Background(), message+"!", sig.Signature); err == nil && signer == want {
+		t.Error("expected tampered message to recover another address")
+	}
+	if ethSig, _ := (&EthereumClient{}).SignMessage(context.Background(), testMessageKey, message); ethSig.Signature == sig.Signature {
+		t.Error("expected TRON prefix to produce a different signature")
+	}
+	if _, err := tr.VerifyMessage(context.Background(), message, "0x1234"); err == nil {
+		t.Error("expected error for short signature")
+	}
+}
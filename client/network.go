@@ -0,0 +1,166 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// FeeModel EVM 網路的手續費模型
+type FeeModel string
+
+const (
+	FeeModelLegacy  FeeModel = "legacy"  // 單一 gasPrice
+	FeeModelEIP1559 FeeModel = "eip1559" // maxFeePerGas + maxPriorityFeePerGas
+)
+
+// Network EVM 網路設定
+// Name：網路名稱，同時作為 API 路由前綴（/api/v1/{name}/...）
+// ChainID：鏈 ID，用於交易簽名與連線校驗
+// RPCURLs：節點 RPC 位址，連線時依序嘗試
+// NativeSymbol：主鏈幣符號
+// FeeModel：手續費模型
+// ExplorerURL：區塊瀏覽器位址
+// Fees：手續費預言機設定
+type Network struct {
+	Name         string    `json:"name"`                   // 網路名稱
+	ChainID      int64     `json:"chain_id"`               // 鏈 ID
+	RPCURLs      []string  `json:"rpc_urls,omitempty"`     // 節點 RPC 位址
+	NativeSymbol string    `json:"native_symbol"`          // 主鏈幣符號
+	FeeModel     FeeModel  `json:"fee_model"`              // 手續費模型
+	ExplorerURL  string    `json:"explorer_url,omitempty"` // 區塊瀏覽器位址
+	Fees         FeeConfig `json:"fees"`                   // 手續費預言機設定
+}
+
+// NodeURLEnv 回傳覆寫此網路 RPC 位址的環境變數名稱，例如 eth → ETH_NODE_URL
+func (n Network) NodeURLEnv() string {
+	return strings.ToUpper(strings.ReplaceAll(n.Name, "-", "_")) + "_NODE_URL"
+}
+
+// DefaultNetworks 內建的 EVM 網路設定，RPC 位址需由環境變數或設定檔提供
+var DefaultNetworks = []Network{
+	{Name: "eth", ChainID: 1, NativeSymbol: "ETH", FeeModel: FeeModelEIP1559, ExplorerURL: "https://etherscan.io"},
+	{Name: "bsc", ChainID: 56, NativeSymbol: "BNB", FeeModel: FeeModelLegacy, ExplorerURL: "https://bscscan.com"},
+	{Name: "polygon", ChainID: 137, NativeSymbol: "POL", FeeModel: FeeModelEIP1559, ExplorerURL: "https://polygonscan.com"},
+	{Name: "arbitrum", ChainID: 42161, NativeSymbol: "ETH", FeeModel: FeeModelEIP1559, ExplorerURL: "https://arbiscan.io"},
+	{Name: "optimism", ChainID: 10, NativeSymbol: "ETH", FeeModel: FeeModelEIP1559, ExplorerURL: "https://optimistic.etherscan.io"},
+}
+
+// networkNamePattern 網路名稱須可直接作為路由片段
+var networkNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// reservedNetworkNames 不可作為網路名稱的路由片段：波場的路由前綴、/api/v1 下的靜態路由與各鏈路由的第一段
+// 網路名稱直接作為 /api/v1/{network} 前綴，與這些片段同名時路由會互相混淆
+var reservedNetworkNames = map[string]struct{}{
+	string(Tron): {}, "networks": {}, "chains": {}, "swagger": {},
+	"connect": {}, "capabilities": {}, "wallet": {}, "address": {}, "balance": {}, "transfer": {},
+	"token": {}, "contract": {}, "block": {}, "tx": {}, "stream": {}, "message": {}, "permit": {},
+	"fees": {}, "trc10": {}, "resources": {}, "stake": {}, "vote": {}, "rewards": {},
+	"permission": {}, "multisig": {}, "offline": {},
+}
+
+// IsReservedNetworkName 檢查名稱是否為保留的路由片段
+func IsReservedNetworkName(name string) bool {
+	_, ok := reservedNetworkNames[name]
+	return ok
+}
+
+// NetworkRegistry EVM 網路註冊表，依註冊順序保存網路設定
+type NetworkRegistry struct {
+	networks map[string]Network
+	order    []string
+}
+
+// NewNetworkRegistry 建立網路註冊表並註冊傳入的網路
+func NewNetworkRegistry(networks ...Network) (*NetworkRegistry, error) {
+	r := &NetworkRegistry{networks: make(map[string]Network)}
+	for _, n := range networks {
+		if err := r.Register(n); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// LoadNetworkRegistry 從 JSON 設定檔載入網路註冊表，path 為空時使用內建網路
+// 載入後以 {NAME}_NODE_URL 環境變數（逗號分隔）覆寫各網路的 RPC 位址
+func LoadNetworkRegistry(path string) (*NetworkRegistry, error) {
+	networks := DefaultNetworks
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read network config: %w", err)
+		}
+		networks = nil
+		if err := json.Unmarshal(data, &networks); err != nil {
+			return nil, fmt.Errorf("parse network config: %w", err)
+		}
+	}
+	r, err := NewNetworkRegistry()
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range networks {
+		if urls := os.Getenv(n.NodeURLEnv()); urls != "" {
+			n.RPCURLs = splitURLs(urls)
+		}
+		if err := r.Register(n); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Register 註冊網路，名稱重複或設定不完整時回傳錯誤
+func (r *NetworkRegistry) Register(n Network) error {
+	if !networkNamePattern.MatchString(n.Name) {
+		return fmt.Errorf("invalid network name %q", n.Name)
+	}
+	if IsReservedNetworkName(n.Name) {
+		return fmt.Errorf("network name %q is reserved", n.Name)
+	}
+	if _, exists := r.networks[n.Name]; exists {
+		return fmt.Errorf("network %q already registered", n.Name)
+	}
+	if n.ChainID <= 0 {
+		return fmt.Errorf("network %q: chain_id must be positive", n.Name)
+	}
+	switch n.FeeModel {
+	case FeeModelLegacy, FeeModelEIP1559:
+	case "":
+		n.FeeModel = FeeModelEIP1559
+	default:
+		return fmt.Errorf("network %q: unknown fee_model %q", n.Name, n.FeeModel)
+	}
+	r.networks[n.Name] = n
+	r.order = append(r.order, n.Name)
+	return nil
+}
+
+// Get 依名稱取得網路設定
+func (r *NetworkRegistry) Get(name string) (Network, bool) {
+	n, ok := r.networks[name]
+	return n, ok
+}
+
+// List 依註冊順序列出所有網路
+func (r *NetworkRegistry) List() []Network {
+	list := make([]Network, 0, len(r.order))
+	for _, name := range r.order {
+		list = append(list, r.networks[name])
+	}
+	return list
+}
+
+// splitURLs 拆分逗號分隔的 URL 列表並去除空白
+func splitURLs(s string) []string {
+	var urls []string
+	for _, u := range strings.Split(s, ",") {
+		if u = strings.TrimSpace(u); u != "" {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadNetworkRegistry_Defaults(t *testing.T) {
+	t.Setenv("BSC_NODE_URL", "https://bsc-a.example, https://bsc-b.example")
+	r, err := LoadNetworkRegistry("")
+	if err != nil {
+		t.Fatalf("LoadNetworkRegistry failed: %v", err)
+	}
+	if len(r.List()) != len(DefaultNetworks) {
+		t.Errorf("expected %d networks, got %d", len(DefaultNetworks), len(r.List()))
+	}
+	bsc, ok := r.Get("bsc")
+	if !ok {
+		t.Fatal("bsc network not registered")
+	}
+	if bsc.ChainID != 56 || bsc.FeeModel != FeeModelLegacy {
+		t.Errorf("unexpected bsc network: %+v", bsc)
+	}
+	if len(bsc.RPCURLs) != 2 || bsc.RPCURLs[1] != "https://bsc-b.example" {
+		t.Errorf("BSC_NODE_URL override not applied: %v", bsc.RPCURLs)
+	}
+}
+
+func TestLoadNetworkRegistry_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "networks.json")
+	config := `[{"name":"base","chain_id":8453,"native_symbol":"ETH","rpc_urls":["https://base.example"]}]`
+	if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	r, err := LoadNetworkRegistry(path)
+	if err != nil {
+		t.Fatalf("LoadNetworkRegistry failed: %v", err)
+	}
+	base, ok := r.Get("base")
+	if !ok || base.FeeModel != FeeModelEIP1559 {
+		t.Errorf("unexpected base network: %+v, %v", base, ok)
+	}
+	if _, ok := r.Get("eth"); ok {
+		t.Error("config file should replace the default networks")
+	}
+}
+
+func TestNetworkRegistry_RegisterErrors(t *testing.T) {
+	r, _ := NewNetworkRegistry()
+	if err := r.Register(Network{Name: "eth", ChainID: 1}); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	bad := []Network{
+		{Name: "eth", ChainID: 1},
+		{Name: "tron", ChainID: 1},
+		{Name: "networks", ChainID: 1},
+		{Name: "chains", ChainID: 1},
+		{Name: "address", ChainID: 1},
+		{Name: "Bad/Name", ChainID: 1},
+		{Name: "zero", ChainID: 0},
+		{Name: "gas", ChainID: 5, FeeModel: "flat"},
+	}
+	for _, n := range bad {
+		if err := r.Register(n); err == nil {
+			t.Errorf("expected error registering %+v", n)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/blockchain-sdk-go/api/types"
+)
+
+// ErrReorgTooDeep 鏈重組深度超過追蹤視窗，無法找到共同祖先
+var ErrReorgTooDeep = errors.New("chain reorganization deeper than tracked window")
+
+// ErrStaleBlock 區塊的父區塊雜湊與追蹤的主鏈不符，但節點回報的主鏈未重組
+// 通常為落後或經負載平衡的 RPC 節點回應不一致，應稍後重試同一高度
+var ErrStaleBlock = errors.New("block does not extend the tracked chain")
+
+// BlockRef 區塊識別與父區塊雜湊
+type BlockRef struct {
+	Number     uint64 `json:"number"`      // 區塊高度
+	Hash       string `json:"hash"`        // 區塊雜湊
+	ParentHash string `json:"parent_hash"` // 父區塊雜湊
+}
+
+// blockRefSource 依高度查詢主鏈區塊識別，由各鏈 client 實作
+type blockRefSource interface {
+	blockRefByNumber(ctx context.Context, number uint64) (BlockRef, error)
+}
+
+// BlockTracker 保存最近一段主鏈區塊雜湊，依父區塊雜湊偵測鏈重組並回滾至共同祖先
+// 非併發安全，應由單一區塊跟隨流程使用
+type BlockTracker struct {
+	source blockRefSource
+	window int
+	blocks []BlockRef // 依高度遞增且連續
+}
+
+// NewBlockTracker 建立區塊追蹤器，window 為保留的最近區塊數
+// client 須為已連線的 EthereumClient 或 TronClient
+func NewBlockTracker(c types.BlockchainClient, window int) (*BlockTracker, error) {
+	source, ok := c.(blockRefSource)
+	if !ok {
+		return nil, ErrUnsupportedBlockchain
+	}
+	return newBlockTracker(source, window), nil
+}
+
+func newBlockTracker(source blockRefSource, window int) *BlockTracker {
+	if window < 2 {
+		window = 2
+	}
+	return &BlockTracker{source: source, window: window}
+}
+
+// Last 回傳最新追蹤的區塊
+func (t *BlockTracker) Last() (BlockRef, bool) {
+	if len(t.blocks) == 0 {
+		return BlockRef{}, false
+	}
+	return t.blocks[len(t.blocks)-1], true
+}
+
+// Advance 追加下一個區塊
+// 父區塊雜湊與最新追蹤區塊不符時視為鏈重組：回滾至共同祖先並回傳被回滾的區塊（依高度遞增），
+// 此時 ref 不會被加入，呼叫端應從共同祖先的下一個高度重新處理
+// 父區塊雜湊不符但追蹤的區塊仍在主鏈上時不回滾，回傳 ErrStaleBlock
+func (t *BlockTracker) Advance(ctx context.Context, ref BlockRef) ([]BlockRef, error) {
+	last, ok := t.Last()
+	if !ok {
+		t.push(ref)
+		return nil, nil
+	}
+	if ref.Number != last.Number+1 {
+		return nil, fmt.Errorf("block tracker expects block %d, got %d", last.Number+1, ref.Number)
+	}
+	if ref.ParentHash == last.Hash {
+		t.push(ref)
+		return nil, nil
+	}
+	reverted, err := t.rollbackToAncestor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(reverted) == 0 {
+		return nil, fmt.Errorf("%w: block %d has parent %s, tracked block %d is %s", ErrStaleBlock, ref.Number, ref.ParentHash, last.Number, last.Hash)
+	}
+	return reverted, nil
+}
+
+// VerifyTip 確認最新追蹤區塊仍在主鏈上，用於偵測同高度被替換的重組
+// 發生重組時回滾並回傳被回滾的區塊
+func (t *BlockTracker) VerifyTip(ctx context.Context) ([]BlockRef, error) {
+	last, ok := t.Last()
+	if !ok {
+		return nil, nil
+	}
+	canonical, err := t.source.blockRefByNumber(ctx, last.Number)
+	if err != nil {
+		return nil, err
+	}
+	if canonical.Hash == last.Hash {
+		return nil, nil
+	}
+	return t.rollbackToAncestor(ctx)
+}
+
+// IsCanonical 檢查區塊是否為追蹤中的主鏈區塊
+func (t *BlockTracker) IsCanonical(number uint64, hash string) bool {
+	ref, ok := t.get(number)
+	return ok && ref.Hash == hash
+}
+
+// Confirmations 依追蹤的主鏈計算區塊確認數，區塊不在主鏈上時回傳 false
+func (t *BlockTracker) Confirmations(number uint64, hash string) (uint64, bool) {
+	if !t.IsCanonical(number, hash) {
+		return 0, false
+	}
+	last, _ := t.Last()
+	return confirmations(number, last.Number), true
+}
+
+// rollbackToAncestor 由新到舊比對主鏈雜湊找出共同祖先，移除其後的區塊
+func (t *BlockTracker) rollbackToAncestor(ctx context.Context) ([]BlockRef, error) {
+	for i := len(t.blocks) - 1; i >= 0; i-- {
+		canonical, err := t.source.blockRefByNumber(ctx, t.blocks[i].Number)
+		if err != nil {
+			return nil, err
+		}
+		if canonical.Hash == t.blocks[i].Hash {
+			reverted := append([]BlockRef(nil), t.blocks[i+1:]...)
+			t.blocks = t.blocks[:i+1]
+			return reverted, nil
+		}
+	}
+	return nil, ErrReorgTooDeep
+}
+
+// restore 以保存的區塊還原追蹤狀態，區塊須依高度遞增且連續
+func (t *BlockTracker) restore(blocks []BlockRef) error {
+	for i := 1; i < len(blocks); i++ {
+		if blocks[i].Number != blocks[i-1].Number+1 || blocks[i].ParentHash != blocks[i-1].Hash {
+			return fmt.Errorf("saved blocks are not a chain at block %d", blocks[i].Number)
+		}
+	}
+	t.blocks = nil
+	for _, b := range blocks {
+		t.push(b)
+	}
+	return nil
+}
+
+// get 依高度取得追蹤中的區塊
+func (t *BlockTracker) get(number uint64) (BlockRef, bool) {
+	if len(t.blocks) == 0 || number < t.blocks[0].Number {
+		return BlockRef{}, false
+	}
+	i := int(number - t.blocks[0].Number)
+	if i >= len(t.blocks) {
+		return BlockRef{}, false
+	}
+	return t.blocks[i], true
+}
+
+// push 加入區塊並維持視窗大小
+func (t *BlockTracker) push(ref BlockRef) {
+	t.blocks = append(t.blocks, ref)
+	if len(t.blocks) > t.window {
+		t.blocks = append(t.blocks[:0:0], t.blocks[len(t.blocks)-t.window:]...)
+	}
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestBlockTracker_Advance(t *testing.T) {
+	src := &fakeDepositSource{}
+	tracker := newBlockTracker(src, 4)
+	ctx := context.Background()
+	for n := uint64(1); n <= 6; n++ {
+		ref, _ := src.blockRefByNumber(ctx, n)
+		if reverted, err := tracker.Advance(ctx, ref); err != nil || len(reverted) != 0 {
+			t.Fatalf("block %d: unexpected reorg %v %v", n, reverted, err)
+		}
+	}
+	if len(tracker.blocks) != 4 || tracker.blocks[0].Number != 3 {
+		t.Errorf("window not trimmed: %+v", tracker.blocks)
+	}
+	if c, ok := tracker.Confirmations(5, "h5"); !ok || c != 2 {
+		t.Errorf("expected 2 confirmations, got %d %v", c, ok)
+	}
+	if _, ok := tracker.Confirmations(5, "h5b"); ok {
+		t.Error("non-canonical block should not have confirmations")
+	}
+
+	// 高度 5 起分叉
+	src.forkAt = 5
+	ref, _ := src.blockRefByNumber(ctx, 7)
+	reverted, err := tracker.Advance(ctx, ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reverted) != 2 || reverted[0].Number != 5 || reverted[1].Number != 6 {
+		t.Errorf("unexpected reverted blocks: %+v", reverted)
+	}
+	if last, _ := tracker.Last(); last.Number != 4 {
+		t.Errorf("expected rollback to 4, got %d", last.Number)
+	}
+}
+
+func TestBlockTracker_VerifyTip(t *testing.T) {
+	src := &fakeDepositSource{}
+	tracker := newBlockTracker(src, 8)
+	ctx := context.Background()
+	for n := uint64(1); n <= 3; n++ {
+		ref, _ := src.blockRefByNumber(ctx, n)
+		_, _ = tracker.Advance(ctx, ref)
+	}
+	if reverted, err := tracker.VerifyTip(ctx); err != nil || len(reverted) != 0 {
+		t.Fatalf("unexpected reorg: %v %v", reverted, err)
+	}
+	src.forkAt = 3
+	if reverted, err := tracker.VerifyTip(ctx); err != nil || len(reverted) != 1 || reverted[0].Hash != "h3" {
+		t.Errorf("expected block 3 reverted, got %v %v", reverted, err)
+	}
+}
+
+func TestBlockTracker_TooDeep(t *testing.T) {
+	src := &fakeDepositSource{}
+	tracker := newBlockTracker(src, 2)
+	ctx := context.Background()
+	for n := uint64(5); n <= 7; n++ {
+		ref, _ := src.blockRefByNumber(ctx, n)
+		_, _ = tracker.Advance(ctx, ref)
+	}
+	src.forkAt = 2
+	ref, _ := src.blockRefByNumber(ctx, 8)
+	if _, err := tracker.Advance(ctx, ref); err != ErrReorgTooDeep {
+		t.Errorf("expected ErrReorgTooDeep, got %v", err)
+	}
+}
+
+func TestBlockTracker_StaleBlock(t *testing.T) {
+	src := &fakeDepositSource{}
+	tracker := newBlockTracker(src, 8)
+	ctx := context.Background()
+	for n := uint64(1); n <= 3; n++ {
+		ref, _ := src.blockRefByNumber(ctx, n)
+		_, _ = tracker.Advance(ctx, ref)
+	}
+	// 父區塊雜湊不符但追蹤的區塊仍在主鏈上：不回滾也不加入
+	if reverted, err := tracker.Advance(ctx, BlockRef{Number: 4, Hash: "h4x", ParentHash: "h3x"}); !errors.Is(err, ErrStaleBlock) || len(reverted) != 0 {
+		t.Fatalf("expected ErrStaleBlock, got %v %v", reverted, err)
+	}
+	if last, _ := tracker.Last(); last.Number != 3 || last.Hash != "h3" {
+		t.Errorf("tracker should keep block 3, got %+v", last)
+	}
+	ref, _ := src.blockRefByNumber(ctx, 4)
+	if reverted, err := tracker.Advance(ctx, ref); err != nil || len(reverted) != 0 {
+		t.Errorf("retry of block 4 failed: %v %v", reverted, err)
+	}
+}
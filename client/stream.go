@@ -0,0 +1,15 @@
+package client
+
+// streamBufferSize 訂閱 channel 的緩衝大小
+const streamBufferSize = 64
+
+// trySend 非阻塞送出事件，接收端緩衝已滿時丟棄事件並回傳 false
+// 避免消化較慢的訂閱者拖慢上游訂閱或輪詢
+func trySend[T any](ch chan<- T, v T) bool {
+	select {
+	case ch <- v:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,18 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestTrySendDropsWhenFull(t *testing.T) {
+	ch := make(chan int, 1)
+	if !trySend(ch, 1) {
+		t.Error("expected first send to succeed")
+	}
+	if trySend(ch, 2) {
+		t.Error("expected send on full channel to be dropped")
+	}
+	if v := <-ch; v != 1 {
+		t.Errorf("expected buffered value 1, got %d", v)
+	}
+}
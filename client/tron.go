@@ -2,7 +2,6 @@ package client
 
 import (
 	"context"
-	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"math/big"
@@ -11,36 +10,112 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/fbsobreira/gotron-sdk/pkg/address"
 	"github.com/fbsobreira/gotron-sdk/pkg/client"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
 	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
-	"github.com/golang/protobuf/proto"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // TronClient 實作 BlockchainClient, WalletManager, TokenManager, ContractManager
 
 type TronClient struct {
-	nodeURL string
-	client  *client.GrpcClient
+	nodeURL   string
+	client    *client.GrpcClient
+	transport TronTransport
+	config    TronConfig
 }
 
 var _ types.BlockchainClient = (*TronClient)(nil)
 var _ types.WalletManager = (*TronClient)(nil)
 var _ types.TokenManager = (*TronClient)(nil)
 var _ types.ContractManager = (*TronClient)(nil)
+var _ types.AddressValidator = (*TronClient)(nil)
+var _ types.NetworkInfoProvider = (*TronClient)(nil)
+var _ types.TokenContractManager = (*TronClient)(nil)
+var _ types.TronAddressConverter = (*TronClient)(nil)
+var _ types.ConnectionConfigurer = (*TronClient)(nil)
+var _ types.CapabilityProvider = (*TronClient)(nil)
 
-// Connect 實作 BlockchainClient 介面
+// tronCapabilities 波場 client 提供的功能，註冊表與 /capabilities 共用
+var tronCapabilities = types.CapabilitySet{
+	types.CapabilityWallet,
+	types.CapabilityNativeTransfer,
+	types.CapabilityTokens,
+	types.CapabilityContractDeploy,
+	types.CapabilityContractCall,
+	types.CapabilityBlocks,
+	types.CapabilityEvents,
+	types.CapabilityMessageSigning,
+	types.CapabilityAddressConversion,
+	types.CapabilityTRC10,
+	types.CapabilityResources,
+	types.CapabilityStaking,
+	types.CapabilityVoting,
+	types.CapabilityMultiSig,
+	types.CapabilityOfflineSigning,
+	types.CapabilityMemo,
+}
+
+func init() {
+	Register(Chain{
+		Type:         Tron,
+		Description:  "Tron",
+		Capabilities: tronCapabilities,
+		NodeURLEnv:   "TRON_NODE_URL",
+		New:          newTronClientFromEnv,
+	})
+}
+
+// Capabilities 實作 CapabilityProvider 介面
+func (t *TronClient) Capabilities() types.CapabilitySet {
+	return tronCapabilities
+}
+
+// Connect 實作 BlockchainClient 介面，依 URL scheme 選擇 gRPC 或 HTTP/JSON 傳輸層
 func (t *TronClient) Connect(ctx context.Context, url string) error {
-	cli := client.NewGrpcClient(url)
-	err := cli.Start(grpc.WithTransportCredentials(insecure.NewCredentials()))
+	return t.connect(ctx, url, t.config)
+}
+
+// ConnectWithOptions 實作 ConnectionConfigurer 介面，請求中的 TLS、API key 與逾時覆寫 client 設定
+func (t *TronClient) ConnectWithOptions(ctx context.Context, req types.ConnectRequest) error {
+	return t.connect(ctx, req.URL, t.config.withConnectRequest(req))
+}
+
+// connect 建立傳輸層，成功後取代原有連線並保存使用的設定
+func (t *TronClient) connect(ctx context.Context, url string, cfg TronConfig) error {
+	transport, err := dialTronTransport(ctx, url, cfg)
 	if err != nil {
 		return err
 	}
+	cli := client.NewGrpcClient(url)
+	cli.Client = api.NewWalletClient(transport)
+	if t.transport != nil {
+		t.transport.Close()
+	}
 	t.nodeURL = url
 	t.client = cli
+	t.transport = transport
+	t.config = cfg
 	return nil
 }
 
+// ValidateAddress 實作 AddressValidator 介面，回傳 base58 格式地址
+func (t *TronClient) ValidateAddress(addr string) (string, error) {
+	return ValidateTronAddress(addr)
+}
+
+// ConvertAddress 實作 TronAddressConverter 介面
+func (t *TronClient) ConvertAddress(addr string) (types.TronAddressResponse, error) {
+	return ConvertTronAddress(addr)
+}
+
+// NetworkInfo 實作 NetworkInfoProvider 介面
+func (t *TronClient) NetworkInfo() types.NetworkResponse {
+	return types.NetworkResponse{
+		Name:         string(Tron),
+		NativeSymbol: "TRX",
+		Connected:    t.client != nil,
+	}
+}
+
 // GenerateNewWallet 實作 WalletManager 介面
 func (t *TronClient) GenerateNewWallet() (privateKey string, addr string, err error) {
 	key, err := crypto.GenerateKey()
@@ -57,7 +132,7 @@ func (t *TronClient) GetNativeBalance(ctx context.Context, addr string) (*big.Fl
 	if t.client == nil {
 		return nil, errors.New("Tron client not connected")
 	}
-	tronAddr, err := address.Base58ToAddress(addr)
+	tronAddr, err := parseTronAddress(addr)
 	if err != nil {
 		return nil, err
 	}
@@ -71,61 +146,31 @@ func (t *TronClient) GetNativeBalance(ctx context.Context, addr string) (*big.Fl
 
 // SendNativeToken 实现 TokenManager
 func (t *TronClient) SendNativeToken(ctx context.Context, fromPrivateKey, toAddress string, amount *big.Float) (string, error) {
-	if t.client == nil {
-		return "", errors.New("Tron client not connected")
-	}
-	priv, err := crypto.HexToECDSA(fromPrivateKey)
-	if err != nil {
-		return "", err
-	}
-	fromAddr := address.PubkeyToAddress(priv.PublicKey).String()
-	amt := big.NewInt(0)
-	amount.Int(amt)
-	txn, err := t.client.Transfer(fromAddr, toAddress, amt.Int64())
+	return t.SendNativeTokenWithMemo(ctx, fromPrivateKey, toAddress, amount, "")
+}
+
+// signAndBroadcast 簽署節點建立的交易並廣播，回傳 txid
+func (t *TronClient) signAndBroadcast(signer *TronSigner, ext *api.TransactionExtention) (string, error) {
+	txid, err := signer.SignExtention(ext)
 	if err != nil {
 		return "", err
 	}
-	_, err = t.client.Broadcast(txn.Transaction)
-	if err != nil {
+	if _, err := t.client.Broadcast(ext.GetTransaction()); err != nil {
 		return "", err
 	}
-	txid := getTronTxID(txn.Transaction)
 	return txid, nil
 }
 
-// DeployContract 实现 ContractManager
-func (t *TronClient) DeployContract(ctx context.Context, privateKey, bytecode, abiJSON string, constructorArgs []interface{}) (string, error) {
-	// gotron-sdk 暂无直接合约部署API，需用 TriggerSmartContract 创建合约
-	return "", errors.New("Tron contract deployment not implemented in gotron-sdk, please use TronBox or TronGrid")
-}
-
-// CallContract 实现 ContractManager
-func (t *TronClient) CallContract(ctx context.Context, contractAddress, abiJSON, method string, params []interface{}) (interface{}, error) {
-	// gotron-sdk 支持 TriggerConstantContract 调用合约方法
-	return nil, errors.New("Tron contract call not implemented in this demo, see gotron-sdk TriggerConstantContract")
-}
-
-// TRC20 余额查询
-func (t *TronClient) GetTRC20Balance(ctx context.Context, contractAddress, walletAddress string) (*big.Int, error) {
-	// 需用 TriggerConstantContract 调用 balanceOf
-	return nil, errors.New("TRC20 balanceOf not implemented in this demo")
-}
-
-// TRC20 转账
-func (t *TronClient) TransferTRC20(ctx context.Context, privateKey, contractAddress, toAddress string, amount *big.Int) (string, error) {
-	// 需用 TriggerSmartContract 调用 transfer
-	return "", errors.New("TRC20 transfer not implemented in this demo")
-}
-
 // Close 實作 BlockchainClient 介面
 func (t *TronClient) Close() error {
-	// gotron-sdk 没有 Close 方法，留空
+	if t.transport != nil {
+		return t.transport.Close()
+	}
 	return nil
 }
 
 // getTronTxID 计算 Tron 交易哈希（TxID）
 func getTronTxID(tx *core.Transaction) string {
-	raw, _ := proto.Marshal(tx.GetRawData())
-	hash := sha256.Sum256(raw)
-	return hex.EncodeToString(hash[:])
+	hash, _ := tronTxHash(tx)
+	return hex.EncodeToString(hash)
 }
@@ -0,0 +1,272 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var _ types.BlockReader = (*TronClient)(nil)
+
+// GetBlockNumber 实现 BlockReader
+func (t *TronClient) GetBlockNumber(ctx context.Context) (uint64, error) {
+	if t.client == nil {
+		return 0, errors.New("Tron client not connected")
+	}
+	block, err := t.client.GetNowBlock()
+	if err != nil {
+		return 0, err
+	}
+	return uint64(block.GetBlockHeader().GetRawData().GetNumber()), nil
+}
+
+// GetBlock 实现 BlockReader
+func (t *TronClient) GetBlock(ctx context.Context, block string) (*types.BlockResponse, error) {
+	if t.client == nil {
+		return nil, errors.New("Tron client not connected")
+	}
+	id, err := parseBlockID(block)
+	if err != nil {
+		return nil, err
+	}
+	if id.hash != "" {
+		b, err := t.client.GetBlockByID(id.hash)
+		if err != nil {
+			return nil, err
+		}
+		if b.GetBlockHeader() == nil {
+			return nil, errors.New("block not found")
+		}
+		txs := make([]string, 0, len(b.Transactions))
+		for _, tx := range b.Transactions {
+			txs = append(txs, getTronTxID(tx))
+		}
+		return tronBlockResponse(b.BlockHeader, tronBlockID(b.BlockHeader), txs), nil
+	}
+	var b *api.BlockExtention
+	if id.latest {
+		b, err = t.client.GetNowBlock()
+	} else {
+		b, err = t.client.GetBlockByNum(int64(id.number))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if b.GetBlockHeader() == nil {
+		return nil, errors.New("block not found")
+	}
+	txs := make([]string, 0, len(b.Transactions))
+	for _, tx := range b.Transactions {
+		txs = append(txs, hex.EncodeToString(tx.Txid))
+	}
+	return tronBlockResponse(b.BlockHeader, hex.EncodeToString(b.Blockid), txs), nil
+}
+
+// GetTransaction 实现 BlockReader
+func (t *TronClient) GetTransaction(ctx context.Context, txHash string) (*types.TransactionDetailResponse, error) {
+	if t.client == nil {
+		return nil, errors.New("Tron client not connected")
+	}
+	tx, err := t.client.GetTransactionByID(txHash)
+	if err != nil {
+		return nil, err
+	}
+	contracts := tx.GetRawData().GetContract()
+	if len(contracts) == 0 {
+		return nil, errors.New("transaction has no contract")
+	}
+	detail, err := tronContractDetail(contracts[0])
+	if err != nil {
+		return nil, err
+	}
+	detail.TxHash = getTronTxID(tx)
+	detail.Memo = tronMemo(tx.GetRawData().GetData())
+	// 尚未寫入區塊的交易查不到 TransactionInfo，其餘錯誤（逾時、連線、驗證失敗）照常回傳
+	info, found, err := t.transactionInfo(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		detail.Pending = true
+		return detail, nil
+	}
+	detail.BlockNumber = uint64(info.BlockNumber)
+	return detail, nil
+}
+
+// transactionInfo 查詢交易執行結果，節點回傳空的 TransactionInfo 時 found 為 false
+// gotron-sdk 的 GetTransactionInfoByID 將查無資料與節點錯誤混為同一種錯誤，因此直接呼叫 gRPC 方法
+func (t *TronClient) transactionInfo(ctx context.Context, txHash string) (*core.TransactionInfo, bool, error) {
+	if err := ValidateTxHash(txHash); err != nil {
+		return nil, false, err
+	}
+	id, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(txHash, "0x"), "0X"))
+	if err != nil {
+		return nil, false, err
+	}
+	info, err := t.client.Client.GetTransactionInfoById(ctx, &api.BytesMessage{Value: id})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(info.GetId()) == 0 {
+		return nil, false, nil
+	}
+	if !bytes.Equal(info.GetId(), id) {
+		return nil, false, fmt.Errorf("node returned transaction info for %x", info.GetId())
+	}
+	return info, true, nil
+}
+
+// GetTransactionReceipt 实现 BlockReader
+func (t *TronClient) GetTransactionReceipt(ctx context.Context, txHash string) (*types.ReceiptResponse, error) {
+	if t.client == nil {
+		return nil, errors.New("Tron client not connected")
+	}
+	info, err := t.client.GetTransactionInfoByID(txHash)
+	if err != nil {
+		return nil, err
+	}
+	receipt := info.GetReceipt()
+	// 非合約交易的 receipt.result 為 DEFAULT，以 info.result 判斷是否成功
+	success := info.Result == core.TransactionInfo_SUCESS &&
+		(receipt.GetResult() == core.Transaction_Result_DEFAULT || receipt.GetResult() == core.Transaction_Result_SUCCESS)
+	resp := &types.ReceiptResponse{
+		TxHash:       hex.EncodeToString(info.Id),
+		BlockNumber:  uint64(info.BlockNumber),
+		Success:      success,
+		Fee:          strconv.FormatInt(info.Fee, 10),
+		ResourceUsed: uint64(receipt.GetEnergyUsageTotal()),
+		Logs:         make([]types.LogResponse, 0, len(info.Log)),
+	}
+	if len(info.ContractAddress) > 0 {
+		resp.ContractAddress = address.Address(info.ContractAddress).String()
+	}
+	for _, l := range info.Log {
+		topics := make([]string, 0, len(l.Topics))
+		for _, topic := range l.Topics {
+			topics = append(topics, hex.EncodeToString(topic))
+		}
+		resp.Logs = append(resp.Logs, types.LogResponse{
+			Address: tronAddressFromEVM(l.Address),
+			Topics:  topics,
+			Data:    hex.EncodeToString(l.Data),
+		})
+	}
+	return resp, nil
+}
+
+// tronBlockResponse 將波場區塊頭轉為共用回應結構
+func tronBlockResponse(header *core.BlockHeader, blockID string, txs []string) *types.BlockResponse {
+	raw := header.GetRawData()
+	return &types.BlockResponse{
+		Number:       uint64(raw.GetNumber()),
+		Hash:         blockID,
+		ParentHash:   hex.EncodeToString(raw.GetParentHash()),
+		Timestamp:    raw.GetTimestamp() / 1000,
+		Producer:     address.Address(raw.GetWitnessAddress()).String(),
+		TxCount:      len(txs),
+		Transactions: txs,
+	}
+}
+
+// tronBlockID 計算波場區塊 ID：區塊頭 raw_data 的 SHA-256，前 8 bytes 以區塊高度取代
+func tronBlockID(header *core.BlockHeader) string {
+	raw, _ := proto.Marshal(header.GetRawData())
+	hash := sha256.Sum256(raw)
+	binary.BigEndian.PutUint64(hash[:8], uint64(header.GetRawData().GetNumber()))
+	return hex.EncodeToString(hash[:])
+}
+
+// tronContractDetail 解析波場交易中的合約參數，取出發送方、接收方、金額與呼叫資料
+// 各合約類型的欄位名稱一致（owner_address、to_address、contract_address、amount、call_value、data），
+// 因此以 protoreflect 統一讀取
+func tronContractDetail(contract *core.Transaction_Contract) (*types.TransactionDetailResponse, error) {
+	detail := &types.TransactionDetailResponse{
+		Type:  contract.GetType().String(),
+		Value: "0",
+	}
+	if contract.GetParameter() == nil {
+		return detail, nil
+	}
+	msg, err := contract.GetParameter().UnmarshalNew()
+	if err != nil {
+		return nil, err
+	}
+	m := msg.ProtoReflect()
+	if v, ok := reflectBytes(m, "owner_address"); ok {
+		detail.From = address.Address(v).String()
+	}
+	if v, ok := reflectBytes(m, "to_address"); ok {
+		detail.To = address.Address(v).String()
+	} else if v, ok := reflectBytes(m, "contract_address"); ok {
+		detail.To = address.Address(v).String()
+	}
+	if v, ok := reflectInt(m, "amount"); ok {
+		detail.Value = strconv.FormatInt(v, 10)
+	} else if v, ok := reflectInt(m, "call_value"); ok {
+		detail.Value = strconv.FormatInt(v, 10)
+	}
+	if v, ok := reflectBytes(m, "asset_name"); ok {
+		detail.AssetID = string(v)
+	}
+	if v, ok := reflectBytes(m, "data"); ok {
+		detail.Input = hex.EncodeToString(v)
+	}
+	return detail, nil
+}
+
+// reflectBytes 讀取 bytes 欄位，欄位不存在或為空時回傳 false
+func reflectBytes(m protoreflect.Message, name protoreflect.Name) ([]byte, bool) {
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.BytesKind || !m.Has(fd) {
+		return nil, false
+	}
+	return m.Get(fd).Bytes(), true
+}
+
+// reflectInt 讀取 int64 欄位，欄位不存在或為零時回傳 false
+func reflectInt(m protoreflect.Message, name protoreflect.Name) (int64, bool) {
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.Int64Kind || !m.Has(fd) {
+		return 0, false
+	}
+	return m.Get(fd).Int(), true
+}
+
+// blockRefByNumber 實作 blockRefSource
+func (t *TronClient) blockRefByNumber(ctx context.Context, number uint64) (BlockRef, error) {
+	if t.client == nil {
+		return BlockRef{}, errors.New("Tron client not connected")
+	}
+	block, err := t.client.GetBlockByNum(int64(number))
+	if err != nil {
+		return BlockRef{}, err
+	}
+	return tronBlockRef(block)
+}
+
+// tronBlockRef 取得區塊識別，區塊不存在時回傳錯誤
+func tronBlockRef(block *api.BlockExtention) (BlockRef, error) {
+	raw := block.GetBlockHeader().GetRawData()
+	if raw == nil {
+		return BlockRef{}, errors.New("block not found")
+	}
+	return BlockRef{
+		Number:     uint64(raw.GetNumber()),
+		Hash:       hex.EncodeToString(block.GetBlockid()),
+		ParentHash: hex.EncodeToString(raw.GetParentHash()),
+	}, nil
+}
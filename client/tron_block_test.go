@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestTronContractDetail(t *testing.T) {
+	from := "TJRabPrwbZy45sbavfcjinPJC18kjpRTv8"
+	to := "TVjsyZ7fYF3qLF6BQgPmTEZy1xrNNyVAAA"
+	fromAddr, _ := address.Base58ToAddress(from)
+	toAddr, _ := address.Base58ToAddress(to)
+	param, err := anypb.New(&core.TransferContract{
+		OwnerAddress: fromAddr,
+		ToAddress:    toAddr,
+		Amount:       1500000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	detail, err := tronContractDetail(&core.Transaction_Contract{
+		Type:      core.Transaction_Contract_TransferContract,
+		Parameter: param,
+	})
+	if err != nil {
+		t.Fatalf("tronContractDetail failed: %v", err)
+	}
+	if detail.From != from || detail.To != to || detail.Value != "1500000" {
+		t.Errorf("unexpected detail: %+v", detail)
+	}
+	if detail.Type != "TransferContract" {
+		t.Errorf("unexpected type: %s", detail.Type)
+	}
+}
+
+func TestTronGetTransaction_PendingAndNodeError(t *testing.T) {
+	from, _ := address.Base58ToAddress("TJRabPrwbZy45sbavfcjinPJC18kjpRTv8")
+	to, _ := address.Base58ToAddress("TVjsyZ7fYF3qLF6BQgPmTEZy1xrNNyVAAA")
+	tx := testTronTransfer(t, from, to, 1500000)
+	txID := getTronTxID(tx)
+	txJSON := tronJSONMessage(tx.ProtoReflect())
+	txJSON["txID"] = txID
+
+	var info func(w http.ResponseWriter)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wallet/gettransactionbyid":
+			_ = json.NewEncoder(w).Encode(txJSON)
+		case "/wallet/gettransactioninfobyid":
+			info(w)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	c := &TronClient{}
+	if err := c.Connect(context.Background(), srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	// 節點回傳空物件表示交易尚未寫入區塊
+	info = func(w http.ResponseWriter) { w.Write([]byte("{}")) }
+	detail, err := c.GetTransaction(context.Background(), txID)
+	if err != nil || !detail.Pending {
+		t.Errorf("expected pending transaction, got %+v, %v", detail, err)
+	}
+
+	info = func(w http.ResponseWriter) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": txID, "blockNumber": 7})
+	}
+	detail, err = c.GetTransaction(context.Background(), txID)
+	if err != nil || detail.Pending || detail.BlockNumber != 7 {
+		t.Errorf("expected confirmed transaction in block 7, got %+v, %v", detail, err)
+	}
+
+	// 節點錯誤不可視為待打包
+	info = func(w http.ResponseWriter) { http.Error(w, "upstream timeout", http.StatusBadGateway) }
+	if detail, err := c.GetTransaction(context.Background(), txID); err == nil {
+		t.Errorf("expected node error, got %+v", detail)
+	}
+
+	if _, err := c.GetTransaction(context.Background(), hex.EncodeToString([]byte("short"))); err == nil {
+		t.Error("expected error for invalid transaction id")
+	}
+}
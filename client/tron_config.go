@@ -0,0 +1,151 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blockchain-sdk-go/api/types"
+)
+
+// DefaultTronFeeLimit 合約呼叫預設 fee_limit（sun），100 TRX
+const DefaultTronFeeLimit int64 = 100_000_000
+
+// 波場連線預設值
+const (
+	DefaultTronDialTimeout      = 10 * time.Second // 建立連線逾時
+	DefaultTronKeepaliveTime    = time.Minute      // gRPC keepalive ping 間隔
+	DefaultTronKeepaliveTimeout = 20 * time.Second // 等待 ping 回應逾時
+)
+
+// TronConfig 波場 client 設定
+// FeeLimit：TriggerSmartContract 交易可燃燒的 TRX 上限（sun）
+// APIKey：TronGrid API key，gRPC 以 metadata、HTTP 以 header 帶入 TRON-PRO-API-KEY
+// Headers：每次呼叫附帶的其他 metadata/header
+// TLS：gRPC 與 HTTPS 連線的 TLS 設定，grpcs:// 或 TLS.Enabled 時 gRPC 使用 TLS
+// DialTimeout、KeepaliveTime、KeepaliveTimeout：未設定時使用預設值
+type TronConfig struct {
+	FeeLimit         int64             `json:"fee_limit"`         // 合約呼叫手續費上限（sun）
+	APIKey           string            `json:"api_key"`           // TronGrid API key
+	Headers          map[string]string `json:"headers"`           // 每次呼叫附帶的 metadata/header
+	TLS              types.TLSConfig   `json:"tls"`               // TLS 設定
+	DialTimeout      time.Duration     `json:"dial_timeout"`      // 建立連線逾時
+	KeepaliveTime    time.Duration     `json:"keepalive_time"`    // gRPC keepalive ping 間隔
+	KeepaliveTimeout time.Duration     `json:"keepalive_timeout"` // 等待 ping 回應逾時
+}
+
+// TronConfigFromEnv 從環境變數讀取波場設定
+// TRON_FEE_LIMIT：合約呼叫 fee_limit（sun），未設定時使用 DefaultTronFeeLimit
+// TRON_API_KEY：TronGrid API key
+// TRON_HEADERS：其他 header，格式為 name=value，以逗號分隔
+// TRON_TLS：gRPC 是否使用 TLS
+// TRON_TLS_CA_FILE、TRON_TLS_CERT_FILE、TRON_TLS_KEY_FILE：自訂 CA 與客戶端憑證（PEM 檔案）
+// TRON_TLS_SERVER_NAME：覆寫驗證憑證使用的主機名稱
+// TRON_DIAL_TIMEOUT、TRON_KEEPALIVE_TIME、TRON_KEEPALIVE_TIMEOUT：時間長度，如 10s、1m
+func TronConfigFromEnv() (TronConfig, error) {
+	cfg := TronConfig{
+		FeeLimit: DefaultTronFeeLimit,
+		APIKey:   os.Getenv("TRON_API_KEY"),
+		TLS:      types.TLSConfig{ServerName: os.Getenv("TRON_TLS_SERVER_NAME")},
+	}
+	if v := os.Getenv("TRON_FEE_LIMIT"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || limit <= 0 {
+			return cfg, fmt.Errorf("invalid TRON_FEE_LIMIT %q", v)
+		}
+		cfg.FeeLimit = limit
+	}
+	if v := os.Getenv("TRON_HEADERS"); v != "" {
+		cfg.Headers = map[string]string{}
+		for _, pair := range strings.Split(v, ",") {
+			name, value, ok := strings.Cut(pair, "=")
+			name = strings.TrimSpace(name)
+			if !ok || name == "" {
+				return cfg, fmt.Errorf("invalid TRON_HEADERS entry %q", pair)
+			}
+			cfg.Headers[name] = strings.TrimSpace(value)
+		}
+	}
+	if v := os.Getenv("TRON_TLS"); v != "" {
+		enabled, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid TRON_TLS %q", v)
+		}
+		cfg.TLS.Enabled = enabled
+	}
+	for _, f := range []struct {
+		env string
+		out *string
+	}{
+		{"TRON_TLS_CA_FILE", &cfg.TLS.CACert},
+		{"TRON_TLS_CERT_FILE", &cfg.TLS.ClientCert},
+		{"TRON_TLS_KEY_FILE", &cfg.TLS.ClientKey},
+	} {
+		path := os.Getenv(f.env)
+		if path == "" {
+			continue
+		}
+		pem, err := os.ReadFile(path)
+		if err != nil {
+			return cfg, fmt.Errorf("read %s: %w", f.env, err)
+		}
+		*f.out = string(pem)
+	}
+	for _, d := range []struct {
+		env string
+		out *time.Duration
+	}{
+		{"TRON_DIAL_TIMEOUT", &cfg.DialTimeout},
+		{"TRON_KEEPALIVE_TIME", &cfg.KeepaliveTime},
+		{"TRON_KEEPALIVE_TIMEOUT", &cfg.KeepaliveTimeout},
+	} {
+		v := os.Getenv(d.env)
+		if v == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(v)
+		if err != nil || duration <= 0 {
+			return cfg, fmt.Errorf("invalid %s %q", d.env, v)
+		}
+		*d.out = duration
+	}
+	return cfg, nil
+}
+
+// withConnectRequest 以連線請求中的選項覆寫設定，未提供的選項沿用原值
+func (c TronConfig) withConnectRequest(req types.ConnectRequest) TronConfig {
+	if req.TLS != nil {
+		c.TLS = *req.TLS
+	}
+	if req.APIKey != "" {
+		c.APIKey = req.APIKey
+	}
+	if len(req.Headers) > 0 {
+		headers := make(map[string]string, len(c.Headers)+len(req.Headers))
+		for k, v := range c.Headers {
+			headers[k] = v
+		}
+		for k, v := range req.Headers {
+			headers[k] = v
+		}
+		c.Headers = headers
+	}
+	if req.DialTimeoutSeconds > 0 {
+		c.DialTimeout = time.Duration(req.DialTimeoutSeconds) * time.Second
+	}
+	return c
+}
+
+// headers 回傳每次呼叫附帶的 header，API key 以 TRON-PRO-API-KEY 帶入
+func (c TronConfig) headers() map[string]string {
+	out := make(map[string]string, len(c.Headers)+1)
+	for k, v := range c.Headers {
+		out[k] = v
+	}
+	if c.APIKey != "" {
+		out["TRON-PRO-API-KEY"] = c.APIKey
+	}
+	return out
+}
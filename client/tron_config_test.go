@@ -0,0 +1,93 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/blockchain-sdk-go/api/types"
+)
+
+func TestTronConfigFromEnv(t *testing.T) {
+	t.Setenv("TRON_FEE_LIMIT", "")
+	cfg, err := TronConfigFromEnv()
+	if err != nil || cfg.FeeLimit != DefaultTronFeeLimit {
+		t.Errorf("expected default fee limit, got %d %v", cfg.FeeLimit, err)
+	}
+
+	t.Setenv("TRON_FEE_LIMIT", "30000000")
+	cfg, err = TronConfigFromEnv()
+	if err != nil || cfg.FeeLimit != 30000000 {
+		t.Errorf("expected fee limit 30000000, got %d %v", cfg.FeeLimit, err)
+	}
+
+	t.Setenv("TRON_API_KEY", "test-key")
+	cfg, err = TronConfigFromEnv()
+	if err != nil || cfg.APIKey != "test-key" {
+		t.Errorf("expected api key from env, got %q %v", cfg.APIKey, err)
+	}
+
+	t.Setenv("TRON_FEE_LIMIT", "abc")
+	if _, err := TronConfigFromEnv(); err == nil {
+		t.Error("expected error for invalid fee limit")
+	}
+}
+
+func TestTronConfigFromEnv_Connection(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte("ca-pem"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TRON_TLS", "true")
+	t.Setenv("TRON_TLS_CA_FILE", caFile)
+	t.Setenv("TRON_HEADERS", "X-Client=sdk, X-Team = ops")
+	t.Setenv("TRON_DIAL_TIMEOUT", "3s")
+	cfg, err := TronConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.TLS.Enabled || cfg.TLS.CACert != "ca-pem" {
+		t.Errorf("unexpected TLS config %+v", cfg.TLS)
+	}
+	if cfg.Headers["X-Client"] != "sdk" || cfg.Headers["X-Team"] != "ops" {
+		t.Errorf("unexpected headers %v", cfg.Headers)
+	}
+	if cfg.DialTimeout != 3*time.Second {
+		t.Errorf("expected dial timeout 3s, got %s", cfg.DialTimeout)
+	}
+
+	for env, value := range map[string]string{
+		"TRON_TLS":          "maybe",
+		"TRON_TLS_CA_FILE":  filepath.Join(t.TempDir(), "missing.pem"),
+		"TRON_HEADERS":      "no-value",
+		"TRON_DIAL_TIMEOUT": "-1s",
+	} {
+		t.Run(env, func(t *testing.T) {
+			t.Setenv(env, value)
+			if _, err := TronConfigFromEnv(); err == nil {
+				t.Errorf("expected error for %s=%q", env, value)
+			}
+		})
+	}
+}
+
+func TestTronConfig_WithConnectRequest(t *testing.T) {
+	base := TronConfig{FeeLimit: 1, APIKey: "env-key", Headers: map[string]string{"A": "1"}}
+	cfg := base.withConnectRequest(types.ConnectRequest{
+		APIKey:             "req-key",
+		Headers:            map[string]string{"B": "2"},
+		TLS:                &types.TLSConfig{Enabled: true},
+		DialTimeoutSeconds: 5,
+	})
+	if cfg.FeeLimit != 1 || cfg.APIKey != "req-key" || !cfg.TLS.Enabled || cfg.DialTimeout != 5*time.Second {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+	headers := cfg.headers()
+	if headers["A"] != "1" || headers["B"] != "2" || headers["TRON-PRO-API-KEY"] != "req-key" {
+		t.Errorf("unexpected headers %v", headers)
+	}
+	if len(base.Headers) != 1 {
+		t.Error("connect options must not modify the base config")
+	}
+}
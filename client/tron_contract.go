@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/contract"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+)
+
+// defaultTronOriginEnergyLimit 合約開發者每次呼叫最多提供的能量預設值
+const defaultTronOriginEnergyLimit int64 = 10_000_000
+
+// DeployContract 實作 ContractManager 介面
+// 建構子參數以 ABI 編碼後接在 bytecode 之後，簽名廣播後回傳合約地址與 txid
+func (t *TronClient) DeployContract(ctx context.Context, privateKey, bytecode, abiJSON string, constructorArgs []interface{}, opts types.DeployOptions) (string, string, error) {
+	if p := opts.ConsumeUserResourcePercent; p != nil && (*p < 0 || *p > 100) {
+		return "", "", fmt.Errorf("consume_user_resource_percent must be between 0 and 100, got %d", *p)
+	}
+	if t.client == nil {
+		return "", "", errors.New("Tron client not connected")
+	}
+	signer, err := NewTronSigner(privateKey)
+	if err != nil {
+		return "", "", err
+	}
+	contractABI, err := contract.JSONtoABI(abiJSON)
+	if err != nil {
+		return "", "", err
+	}
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return "", "", err
+	}
+	args, err := convertABIArgs(parsedABI.Constructor.Inputs, constructorArgs, parseTronABIAddress)
+	if err != nil {
+		return "", "", err
+	}
+	packedArgs, err := parsedABI.Pack("", args...)
+	if err != nil {
+		return "", "", err
+	}
+	code, err := hex.DecodeString(strings.TrimPrefix(bytecode, "0x"))
+	if err != nil {
+		return "", "", err
+	}
+	code = append(code, packedArgs...)
+
+	percent := int64(100)
+	if opts.ConsumeUserResourcePercent != nil {
+		percent = *opts.ConsumeUserResourcePercent
+	}
+	originEnergyLimit := opts.OriginEnergyLimit
+	if originEnergyLimit <= 0 {
+		originEnergyLimit = defaultTronOriginEnergyLimit
+	}
+	feeLimit := opts.FeeLimit
+	if feeLimit <= 0 {
+		feeLimit = t.feeLimit()
+	}
+
+	owner := signer.Address()
+	ext, err := t.client.DeployContract(owner.String(), opts.Name, contractABI, hex.EncodeToString(code), feeLimit, percent, originEnergyLimit)
+	if err != nil {
+		return "", "", err
+	}
+	if ext.GetResult().GetCode() != api.Return_SUCCESS {
+		return "", "", fmt.Errorf("deploy contract failed (%s): %s", ext.GetResult().GetCode(), ext.GetResult().GetMessage())
+	}
+	txid, err := t.signAndBroadcast(signer, ext)
+	if err != nil {
+		return "", "", err
+	}
+	txHash, err := hex.DecodeString(txid)
+	if err != nil {
+		return "", "", err
+	}
+	return tronContractAddress(txHash, owner).String(), txid, nil
+}
+
+// tronContractAddress 依部署交易計算合約地址：0x41 ‖ keccak256(txid ‖ owner)[12:]
+func tronContractAddress(txid []byte, owner address.Address) address.Address {
+	hash := crypto.Keccak256(txid, owner.Bytes())
+	return append(address.Address{address.TronBytePrefix}, hash[12:]...)
+}
+
+// parseTronABIAddress 將波場地址轉為 ABI address 值
+func parseTronABIAddress(s string) (common.Address, error) {
+	addr, err := parseTronAddress(s)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return tronToEVMAddress(addr), nil
+}
+
+// CallContract 實作 ContractManager 介面
+// 參數中的地址可為波場 base58 格式，以 TriggerConstantContract 執行並依 ABI 解碼回傳值
+func (t *TronClient) CallContract(ctx context.Context, contractAddress, abiJSON, method string, params []interface{}) (interface{}, error) {
+	if t.client == nil {
+		return nil, errors.New("Tron client not connected")
+	}
+	contractAddr, err := parseTronAddress(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	parsedABI, data, err := packContractCall(abiJSON, method, params, parseTronABIAddress)
+	if err != nil {
+		return nil, err
+	}
+	output, err := t.triggerConstant(nil, contractAddr, data)
+	if err != nil {
+		return nil, err
+	}
+	return decodeContractOutput(parsedABI, method, output, formatTronAddress)
+}
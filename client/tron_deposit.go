@@ -0,0 +1,138 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strconv"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+)
+
+var _ depositSource = (*TronClient)(nil)
+
+// scanDepositBlock 實作 depositSource
+// TRX 以 TransferContract、TRC10 以 TransferAssetContract 偵測，TRC20 以區塊交易資訊中的 Transfer 事件偵測
+// TRC10 資產與 TRC20 合約須在 tokens 白名單中
+func (t *TronClient) scanDepositBlock(ctx context.Context, number uint64, watched, tokens *watchSet) (BlockRef, []Deposit, error) {
+	if t.client == nil {
+		return BlockRef{}, nil, errors.New("Tron client not connected")
+	}
+	block, err := t.client.GetBlockByNum(int64(number))
+	if err != nil {
+		return BlockRef{}, nil, err
+	}
+	ref, err := tronBlockRef(block)
+	if err != nil {
+		return BlockRef{}, nil, err
+	}
+
+	var deposits []Deposit
+	hasContractCall := false
+	// 合約呼叫的備註依 txid 暫存，供 TRC20 事件使用
+	memos := map[string]string{}
+	for _, txe := range block.Transactions {
+		tx := txe.GetTransaction()
+		contracts := tx.GetRawData().GetContract()
+		if len(contracts) == 0 {
+			continue
+		}
+		if contracts[0].GetType() == core.Transaction_Contract_TriggerSmartContract {
+			hasContractCall = true
+			if memo := tronMemo(tx.GetRawData().GetData()); memo != "" {
+				memos[hex.EncodeToString(txe.GetTxid())] = memo
+			}
+			continue
+		}
+		d, ok, err := tronTransferDeposit(contracts[0])
+		if err != nil {
+			return BlockRef{}, nil, err
+		}
+		if !ok || !watched.contains(d.To) || (d.Token != "" && !tokens.contains(d.Token)) || !tronTxSucceeded(tx) {
+			continue
+		}
+		d.TxHash = hex.EncodeToString(txe.GetTxid())
+		d.Memo = tronMemo(tx.GetRawData().GetData())
+		deposits = append(deposits, d)
+	}
+
+	// 區塊沒有合約呼叫時不會有 TRC20 轉帳，省去查詢交易資訊
+	if !hasContractCall || len(watched.list()) == 0 || len(tokens.list()) == 0 {
+		return ref, deposits, nil
+	}
+	infos, err := t.client.GetBlockInfoByNum(int64(number))
+	if err != nil {
+		return BlockRef{}, nil, err
+	}
+	for _, info := range infos.GetTransactionInfo() {
+		if info.GetReceipt().GetResult() != core.Transaction_Result_SUCCESS {
+			continue
+		}
+		for i, l := range info.GetLog() {
+			if len(l.Topics) != 3 || !bytes.Equal(l.Topics[0], transferEventSig.Bytes()) {
+				continue
+			}
+			to, token := tronAddressFromEVM(l.Topics[2][12:]), tronAddressFromEVM(l.Address)
+			if !watched.contains(to) || !tokens.contains(token) {
+				continue
+			}
+			txHash := hex.EncodeToString(info.GetId())
+			deposits = append(deposits, Deposit{
+				TxHash:   txHash,
+				LogIndex: uint(i),
+				From:     tronAddressFromEVM(l.Topics[1][12:]),
+				To:       to,
+				Token:    token,
+				Amount:   new(big.Int).SetBytes(l.Data).String(),
+				Memo:     memos[txHash],
+			})
+		}
+	}
+	return ref, deposits, nil
+}
+
+// tronTransferDeposit 解析 TRX 與 TRC10 轉帳，其他合約類型回傳 false
+func tronTransferDeposit(contract *core.Transaction_Contract) (Deposit, bool, error) {
+	switch contract.GetType() {
+	case core.Transaction_Contract_TransferContract:
+		var transfer core.TransferContract
+		if err := contract.GetParameter().UnmarshalTo(&transfer); err != nil {
+			return Deposit{}, false, err
+		}
+		return Deposit{
+			From:   address.Address(transfer.OwnerAddress).String(),
+			To:     address.Address(transfer.ToAddress).String(),
+			Amount: strconv.FormatInt(transfer.Amount, 10),
+		}, true, nil
+	case core.Transaction_Contract_TransferAssetContract:
+		var transfer core.TransferAssetContract
+		if err := contract.GetParameter().UnmarshalTo(&transfer); err != nil {
+			return Deposit{}, false, err
+		}
+		return Deposit{
+			From:   address.Address(transfer.OwnerAddress).String(),
+			To:     address.Address(transfer.ToAddress).String(),
+			Token:  string(transfer.AssetName),
+			Amount: strconv.FormatInt(transfer.Amount, 10),
+		}, true, nil
+	}
+	return Deposit{}, false, nil
+}
+
+// tronTxSucceeded 檢查區塊內交易的執行結果
+func tronTxSucceeded(tx *core.Transaction) bool {
+	for _, ret := range tx.GetRet() {
+		if ret.GetContractRet() != core.Transaction_Result_SUCCESS && ret.GetContractRet() != core.Transaction_Result_DEFAULT {
+			return false
+		}
+	}
+	return true
+}
+
+// tronAddressFromEVM 將 20 bytes 的 EVM 格式地址（合約事件中的地址）轉為 base58 波場地址
+func tronAddressFromEVM(b []byte) string {
+	return address.Address(append([]byte{address.TronBytePrefix}, b...)).String()
+}
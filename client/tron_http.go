@@ -0,0 +1,299 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// tronHTTPMaxResponse 單次 HTTP 回應大小上限
+const tronHTTPMaxResponse = 32 << 20
+
+// tronHTTPTransport 透過波場 HTTP /wallet API 呼叫節點
+// 請求與回應以 tronJSONMessage/decodeTronJSON 轉換，API key 放在 TRON-PRO-API-KEY header
+type tronHTTPTransport struct {
+	baseURL string
+	headers map[string]string
+	client  *http.Client
+}
+
+// tronHTTPMethod Wallet gRPC 方法對應的 HTTP 端點
+// request 與 reply 用於兩者格式不同的端點，未設定時直接轉換訊息
+type tronHTTPMethod struct {
+	path    string
+	request func(proto.Message) (interface{}, error)
+	reply   func(interface{}) (interface{}, error)
+}
+
+// tronHTTPMethods 以 gRPC 完整方法名稱查詢 HTTP 端點
+var tronHTTPMethods = map[string]tronHTTPMethod{
+	"/protocol.Wallet/GetAccount":                         {path: "/wallet/getaccount"},
+	"/protocol.Wallet/GetAccountResource":                 {path: "/wallet/getaccountresource"},
+	"/protocol.Wallet/GetChainParameters":                 {path: "/wallet/getchainparameters"},
+	"/protocol.Wallet/GetNowBlock2":                       {path: "/wallet/getnowblock", reply: tronHTTPBlock},
+	"/protocol.Wallet/GetBlockByNum2":                     {path: "/wallet/getblockbynum", reply: tronHTTPBlock},
+	"/protocol.Wallet/GetBlockById":                       {path: "/wallet/getblockbyid"},
+	"/protocol.Wallet/GetTransactionById":                 {path: "/wallet/gettransactionbyid"},
+	"/protocol.Wallet/GetTransactionInfoById":             {path: "/wallet/gettransactioninfobyid"},
+	"/protocol.Wallet/GetTransactionInfoByBlockNum":       {path: "/wallet/gettransactioninfobyblocknum", reply: tronHTTPInfoList},
+	"/protocol.Wallet/GetTransactionListFromPending":      {path: "/wallet/gettransactionlistfrompending"},
+	"/protocol.Wallet/GetTransactionSignWeight":           {path: "/wallet/getsignweight"},
+	"/protocol.Wallet/BroadcastTransaction":               {path: "/wallet/broadcasthex", request: tronHTTPBroadcast},
+	"/protocol.Wallet/CreateTransaction2":                 {path: "/wallet/createtransaction", reply: tronHTTPTransaction},
+	"/protocol.Wallet/TransferAsset2":                     {path: "/wallet/transferasset", reply: tronHTTPTransaction},
+	"/protocol.Wallet/GetAssetIssueById":                  {path: "/wallet/getassetissuebyid", request: tronHTTPAssetID},
+	"/protocol.Wallet/TriggerConstantContract":            {path: "/wallet/triggerconstantcontract", reply: tronHTTPTrigger},
+	"/protocol.Wallet/TriggerContract":                    {path: "/wallet/triggersmartcontract", reply: tronHTTPTrigger},
+	"/protocol.Wallet/EstimateEnergy":                     {path: "/wallet/estimateenergy"},
+	"/protocol.Wallet/DeployContract":                     {path: "/wallet/deploycontract", request: tronHTTPDeploy, reply: tronHTTPTransaction},
+	"/protocol.Wallet/AccountPermissionUpdate":            {path: "/wallet/accountpermissionupdate", reply: tronHTTPTransaction},
+	"/protocol.Wallet/FreezeBalanceV2":                    {path: "/wallet/freezebalancev2", reply: tronHTTPTransaction},
+	"/protocol.Wallet/UnfreezeBalanceV2":                  {path: "/wallet/unfreezebalancev2", reply: tronHTTPTransaction},
+	"/protocol.Wallet/WithdrawExpireUnfreeze":             {path: "/wallet/withdrawexpireunfreeze", reply: tronHTTPTransaction},
+	"/protocol.Wallet/DelegateResource":                   {path: "/wallet/delegateresource", reply: tronHTTPTransaction},
+	"/protocol.Wallet/UnDelegateResource":                 {path: "/wallet/undelegateresource", reply: tronHTTPTransaction},
+	"/protocol.Wallet/GetDelegatedResourceV2":             {path: "/wallet/getdelegatedresourcev2"},
+	"/protocol.Wallet/GetDelegatedResourceAccountIndexV2": {path: "/wallet/getdelegatedresourceaccountindexv2"},
+	"/protocol.Wallet/GetCanDelegatedMaxSize":             {path: "/wallet/getcandelegatedmaxsize"},
+	"/protocol.Wallet/GetCanWithdrawUnfreezeAmount":       {path: "/wallet/getcanwithdrawunfreezeamount"},
+	"/protocol.Wallet/GetAvailableUnfreezeCount":          {path: "/wallet/getavailableunfreezecount"},
+	"/protocol.Wallet/ListWitnesses":                      {path: "/wallet/listwitnesses"},
+	"/protocol.Wallet/VoteWitnessAccount2":                {path: "/wallet/votewitnessaccount", reply: tronHTTPTransaction},
+	"/protocol.Wallet/GetRewardInfo":                      {path: "/wallet/getReward", request: tronHTTPAddress, reply: tronHTTPReward},
+	"/protocol.Wallet/WithdrawBalance2":                   {path: "/wallet/withdrawbalance", reply: tronHTTPTransaction},
+}
+
+// newTronHTTPTransport 建立 HTTP 傳輸層，baseURL 可帶或不帶 /wallet 後綴
+func newTronHTTPTransport(baseURL string, headers map[string]string, tlsConfig *tls.Config, dialTimeout time.Duration) *tronHTTPTransport {
+	baseURL = strings.TrimSuffix(strings.TrimRight(baseURL, "/"), "/wallet")
+	return &tronHTTPTransport{baseURL: baseURL, headers: headers, client: tronHTTPClient(tlsConfig, dialTimeout)}
+}
+
+// Invoke 實作 grpc.ClientConnInterface，將 Wallet 方法轉為 POST /wallet/* 請求
+func (h *tronHTTPTransport) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	m, ok := tronHTTPMethods[method]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "%s is not available over the Tron HTTP API", method)
+	}
+	in, ok := args.(proto.Message)
+	if !ok {
+		return fmt.Errorf("unexpected request type %T", args)
+	}
+	out, ok := reply.(proto.Message)
+	if !ok {
+		return fmt.Errorf("unexpected reply type %T", reply)
+	}
+
+	var body interface{} = tronJSONMessage(in.ProtoReflect())
+	if m.request != nil {
+		var err error
+		if body, err = m.request(in); err != nil {
+			return err
+		}
+	}
+	raw, err := h.post(ctx, m.path, body)
+	if err != nil {
+		return err
+	}
+	if m.reply != nil {
+		if raw, err = m.reply(raw); err != nil {
+			return err
+		}
+	}
+	obj, ok := raw.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected response from %s", m.path)
+	}
+	proto.Reset(out)
+	return decodeTronJSON(obj, out.ProtoReflect())
+}
+
+// NewStream 實作 grpc.ClientConnInterface，HTTP API 不支援串流
+func (h *tronHTTPTransport) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Errorf(codes.Unimplemented, "%s is not available over the Tron HTTP API", method)
+}
+
+// Close 釋放閒置連線
+func (h *tronHTTPTransport) Close() error {
+	h.client.CloseIdleConnections()
+	return nil
+}
+
+// post 送出 JSON 請求並解析回應，節點以 {"Error": ...} 回報的錯誤轉為 error
+func (h *tronHTTPTransport) post(ctx context.Context, path string, body interface{}) (interface{}, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.baseURL+path, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range h.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	payload, err := io.ReadAll(io.LimitReader(resp.Body, tronHTTPMaxResponse))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s: %s", path, resp.Status, strings.TrimSpace(string(payload)))
+	}
+
+	var raw interface{}
+	if len(bytes.TrimSpace(payload)) == 0 {
+		return map[string]interface{}{}, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+	if err := dec.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("%s: invalid response: %w", path, err)
+	}
+	if obj, ok := raw.(map[string]interface{}); ok {
+		if msg, ok := obj["Error"]; ok {
+			return nil, fmt.Errorf("%s: %v", path, msg)
+		}
+	}
+	return raw, nil
+}
+
+// tronHTTPBroadcast 以 broadcasthex 廣播序列化後的交易，避免節點重新解析 JSON
+func tronHTTPBroadcast(in proto.Message) (interface{}, error) {
+	data, err := proto.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"transaction": hex.EncodeToString(data)}, nil
+}
+
+// tronHTTPAssetID HTTP API 的資產 ID 為數字字串而非 hex
+func tronHTTPAssetID(in proto.Message) (interface{}, error) {
+	msg, ok := in.(*api.BytesMessage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected request type %T", in)
+	}
+	return map[string]interface{}{"value": string(msg.GetValue())}, nil
+}
+
+// tronHTTPAddress 以 BytesMessage 傳地址的方法，HTTP API 使用 address 欄位
+func tronHTTPAddress(in proto.Message) (interface{}, error) {
+	msg, ok := in.(*api.BytesMessage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected request type %T", in)
+	}
+	return map[string]interface{}{"address": hex.EncodeToString(msg.GetValue())}, nil
+}
+
+// tronHTTPDeploy deploycontract 使用扁平參數，ABI 為 entrys 陣列的 JSON 字串
+func tronHTTPDeploy(in proto.Message) (interface{}, error) {
+	ct, ok := in.(*core.CreateSmartContract)
+	if !ok {
+		return nil, fmt.Errorf("unexpected request type %T", in)
+	}
+	sc := ct.GetNewContract()
+	entries := []interface{}{}
+	if sc.GetAbi() != nil {
+		if e, ok := tronJSONMessage(sc.GetAbi().ProtoReflect())["entrys"].([]interface{}); ok {
+			entries = e
+		}
+	}
+	abiJSON, err := json.Marshal(entries)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"owner_address":                 hex.EncodeToString(ct.GetOwnerAddress()),
+		"name":                          sc.GetName(),
+		"abi":                           string(abiJSON),
+		"bytecode":                      hex.EncodeToString(sc.GetBytecode()),
+		"call_value":                    sc.GetCallValue(),
+		"consume_user_resource_percent": sc.GetConsumeUserResourcePercent(),
+		"origin_energy_limit":           sc.GetOriginEnergyLimit(),
+	}, nil
+}
+
+// tronHTTPTransaction 將 HTTP 回傳的交易包成 TransactionExtention
+func tronHTTPTransaction(raw interface{}) (interface{}, error) {
+	tx, ok := raw.(map[string]interface{})
+	if !ok || tx["raw_data"] == nil {
+		return nil, fmt.Errorf("node returned no transaction")
+	}
+	return map[string]interface{}{
+		"transaction": tx,
+		"txid":        tx["txID"],
+		"result":      map[string]interface{}{"result": true},
+	}, nil
+}
+
+// tronHTTPTrigger 合約呼叫回應的 txid 在 transaction.txID
+func tronHTTPTrigger(raw interface{}) (interface{}, error) {
+	obj, ok := raw.(map[string]interface{})
+	if !ok {
+		return raw, nil
+	}
+	if tx, ok := obj["transaction"].(map[string]interface{}); ok && obj["txid"] == nil {
+		obj["txid"] = tx["txID"]
+	}
+	return obj, nil
+}
+
+// tronHTTPBlock 將 HTTP 區塊轉為 BlockExtention 格式，交易包成 {transaction, txid}
+func tronHTTPBlock(raw interface{}) (interface{}, error) {
+	block, ok := raw.(map[string]interface{})
+	if !ok {
+		return raw, nil
+	}
+	out := map[string]interface{}{
+		"blockid":      block["blockID"],
+		"block_header": block["block_header"],
+	}
+	if txs, ok := block["transactions"].([]interface{}); ok {
+		exts := make([]interface{}, 0, len(txs))
+		for _, item := range txs {
+			tx, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			exts = append(exts, map[string]interface{}{"transaction": tx, "txid": tx["txID"]})
+		}
+		out["transactions"] = exts
+	}
+	return out, nil
+}
+
+// tronHTTPReward getReward 回傳 {"reward": n}，轉為 NumberMessage
+func tronHTTPReward(raw interface{}) (interface{}, error) {
+	obj, ok := raw.(map[string]interface{})
+	if !ok {
+		return raw, nil
+	}
+	return map[string]interface{}{"num": obj["reward"]}, nil
+}
+
+// tronHTTPInfoList gettransactioninfobyblocknum 回傳陣列，包成 TransactionInfoList
+func tronHTTPInfoList(raw interface{}) (interface{}, error) {
+	if list, ok := raw.([]interface{}); ok {
+		return map[string]interface{}{"transactionInfo": list}, nil
+	}
+	return raw, nil
+}
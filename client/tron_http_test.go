@@ -0,0 +1,185 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// testTronTransfer 建立一筆 TransferContract 交易
+func testTronTransfer(t *testing.T, from, to address.Address, amount int64) *core.Transaction {
+	t.Helper()
+	param, err := anypb.New(&core.TransferContract{OwnerAddress: from, ToAddress: to, Amount: amount})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := testTronTransaction()
+	tx.RawData.Contract = []*core.Transaction_Contract{{
+		Type:      core.Transaction_Contract_TransferContract,
+		Parameter: param,
+	}}
+	return tx
+}
+
+// decodeTestTronJSON 以與 HTTP 傳輸層相同的方式解析 JSON
+func decodeTestTronJSON(t *testing.T, data []byte, m proto.Message) {
+	t.Helper()
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var obj map[string]interface{}
+	if err := dec.Decode(&obj); err != nil {
+		t.Fatal(err)
+	}
+	if err := decodeTronJSON(obj, m.ProtoReflect()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTronJSON_RoundTrip(t *testing.T) {
+	from := address.HexToAddress("41a614f803b6fd780986a42c78ec9c7f77e6ded13c")
+	to := address.HexToAddress("41e552f6487585c2b58bc2c9bb4492bc1f17132cd0")
+	tx := testTronTransfer(t, from, to, 1500000)
+	tx.Signature = [][]byte{bytes.Repeat([]byte{0xab}, 65)}
+
+	obj := tronJSONMessage(tx.ProtoReflect())
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`"owner_address":"41a614f803b6fd780986a42c78ec9c7f77e6ded13c"`,
+		`"type":"TransferContract"`,
+		`"type_url":"type.googleapis.com/protocol.TransferContract"`,
+		`"amount":1500000`,
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("expected %s in %s", want, data)
+		}
+	}
+
+	decoded := new(core.Transaction)
+	decodeTestTronJSON(t, data, decoded)
+	if !proto.Equal(tx, decoded) {
+		t.Errorf("round trip mismatch:\n%v\n%v", tx, decoded)
+	}
+}
+
+func TestTronJSON_AccountAssetList(t *testing.T) {
+	acc := new(core.Account)
+	decodeTestTronJSON(t, []byte(`{"balance":42,"assetV2":[{"key":"1002000","value":7}],"account_name":"616263"}`), acc)
+	if acc.GetBalance() != 42 || acc.GetAssetV2()["1002000"] != 7 || string(acc.GetAccountName()) != "abc" {
+		t.Errorf("unexpected account %v", acc)
+	}
+}
+
+func TestTronJSON_RawDataHex(t *testing.T) {
+	from := address.HexToAddress("41a614f803b6fd780986a42c78ec9c7f77e6ded13c")
+	tx := testTronTransfer(t, from, from, 1)
+	raw, _ := proto.Marshal(tx.GetRawData())
+	// raw_data 內容與 raw_data_hex 不一致時以 raw_data_hex 為準
+	body := `{"raw_data":{"expiration":1},"raw_data_hex":"` + hex.EncodeToString(raw) + `"}`
+	decoded := new(core.Transaction)
+	decodeTestTronJSON(t, []byte(body), decoded)
+	if !proto.Equal(tx.GetRawData(), decoded.GetRawData()) {
+		t.Errorf("expected raw_data from raw_data_hex, got %v", decoded.GetRawData())
+	}
+}
+
+func TestTronClient_HTTPTransport(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	privateKey := hex.EncodeToString(crypto.FromECDSA(key))
+	from := address.PubkeyToAddress(key.PublicKey)
+	to := address.HexToAddress("41e552f6487585c2b58bc2c9bb4492bc1f17132cd0")
+
+	tx := testTronTransfer(t, from, to, 2000000)
+	raw, _ := proto.Marshal(tx.GetRawData())
+	txJSON := tronJSONMessage(tx.ProtoReflect())
+	txJSON["txID"] = getTronTxID(tx)
+	txJSON["raw_data_hex"] = hex.EncodeToString(raw)
+
+	var broadcast *core.Transaction
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("TRON-PRO-API-KEY"); got != "test-key" {
+			t.Errorf("expected api key header, got %q", got)
+		}
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]interface{}
+		_ = json.Unmarshal(body, &req)
+		var resp interface{}
+		switch r.URL.Path {
+		case "/wallet/getaccount":
+			if req["address"] != hex.EncodeToString(from.Bytes()) {
+				t.Errorf("unexpected account request %s", body)
+			}
+			resp = map[string]interface{}{"address": req["address"], "balance": 1500000}
+		case "/wallet/createtransaction":
+			if req["amount"] != float64(2000000) || req["to_address"] != hex.EncodeToString(to.Bytes()) {
+				t.Errorf("unexpected transfer request %s", body)
+			}
+			resp = txJSON
+		case "/wallet/broadcasthex":
+			data, _ := hex.DecodeString(req["transaction"].(string))
+			broadcast = new(core.Transaction)
+			if err := proto.Unmarshal(data, broadcast); err != nil {
+				t.Errorf("invalid broadcast payload: %v", err)
+			}
+			resp = map[string]interface{}{"result": true, "txid": txJSON["txID"]}
+		case "/wallet/gettransactionbyid":
+			resp = map[string]interface{}{"Error": "boom"}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	c := &TronClient{config: TronConfig{APIKey: "test-key"}}
+	if err := c.Connect(context.Background(), srv.URL+"/"); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if _, ok := c.transport.(*tronHTTPTransport); !ok {
+		t.Fatalf("expected HTTP transport for %s, got %T", srv.URL, c.transport)
+	}
+
+	balance, err := c.GetNativeBalance(context.Background(), from.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Cmp(big.NewFloat(1500000)) != 0 {
+		t.Errorf("expected balance 1500000, got %s", balance.String())
+	}
+
+	txid, err := c.SendNativeToken(context.Background(), privateKey, to.String(), big.NewFloat(2000000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if txid != txJSON["txID"] {
+		t.Errorf("expected txid %s, got %s", txJSON["txID"], txid)
+	}
+	if broadcast == nil || len(broadcast.GetSignature()) != 1 {
+		t.Fatalf("expected one signed transaction broadcast, got %v", broadcast)
+	}
+
+	if _, err := c.client.GetTransactionByID(txid); err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("expected node error, got %v", err)
+	}
+	if _, err := c.client.Client.GetNodeInfo(context.Background(), new(api.EmptyMessage)); err == nil {
+		t.Error("expected error for method without HTTP mapping")
+	}
+}
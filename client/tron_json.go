@@ -0,0 +1,300 @@
+package client
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// 波場 HTTP API（visible=false）的 JSON 格式與 proto3 標準 JSON 不同：
+// 欄位名稱沿用 proto 原始名稱，bytes 為 hex 字串，enum 為名稱字串，
+// Any 為 {"type_url", "value"} 且 value 為展開的訊息，map 為 [{"key", "value"}] 陣列
+
+// tronJSONMessage 將 proto 訊息轉為波場 HTTP API 的 JSON 物件
+func tronJSONMessage(m protoreflect.Message) map[string]interface{} {
+	if m.Descriptor().FullName() == "google.protobuf.Any" {
+		return tronJSONAny(m)
+	}
+	out := map[string]interface{}{}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := string(fd.Name())
+		switch {
+		case fd.IsList():
+			list := v.List()
+			items := make([]interface{}, list.Len())
+			for i := range items {
+				items[i] = tronJSONScalar(fd, list.Get(i))
+			}
+			out[name] = items
+		case fd.IsMap():
+			var entries []map[string]interface{}
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				entries = append(entries, map[string]interface{}{
+					"key":   k.Interface(),
+					"value": tronJSONScalar(fd.MapValue(), mv),
+				})
+				return true
+			})
+			sort.Slice(entries, func(i, j int) bool {
+				return fmt.Sprint(entries[i]["key"]) < fmt.Sprint(entries[j]["key"])
+			})
+			out[name] = entries
+		default:
+			out[name] = tronJSONScalar(fd, v)
+		}
+		return true
+	})
+	return out
+}
+
+// tronJSONScalar 轉換單一欄位值
+func tronJSONScalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.BytesKind:
+		return hex.EncodeToString(v.Bytes())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return int32(v.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return tronJSONMessage(v.Message())
+	}
+	return v.Interface()
+}
+
+// tronJSONAny 展開 Any 內的合約訊息，無法辨識的類型以 hex 保留原始內容
+func tronJSONAny(m protoreflect.Message) map[string]interface{} {
+	fields := m.Descriptor().Fields()
+	url := m.Get(fields.ByName("type_url")).String()
+	value := m.Get(fields.ByName("value")).Bytes()
+	mt, err := protoregistry.GlobalTypes.FindMessageByURL(url)
+	if err != nil {
+		return map[string]interface{}{"type_url": url, "value": hex.EncodeToString(value)}
+	}
+	inner := mt.New()
+	if err := proto.Unmarshal(value, inner.Interface()); err != nil {
+		return map[string]interface{}{"type_url": url, "value": hex.EncodeToString(value)}
+	}
+	return map[string]interface{}{"type_url": url, "value": tronJSONMessage(inner)}
+}
+
+// decodeTronJSON 將波場 HTTP API 的 JSON 物件寫入 proto 訊息，忽略未知欄位
+// 交易帶有 raw_data_hex 時直接以原始位元組解碼 raw_data，確保 txid 與節點一致
+func decodeTronJSON(obj map[string]interface{}, m protoreflect.Message) error {
+	desc := m.Descriptor()
+	if desc.FullName() == "google.protobuf.Any" {
+		return decodeTronJSONAny(obj, m)
+	}
+	fields := desc.Fields()
+	rawHex, hasRaw := obj["raw_data_hex"].(string)
+	hasRaw = hasRaw && desc.FullName() == "protocol.Transaction"
+	for key, raw := range obj {
+		fd := fields.ByName(protoreflect.Name(key))
+		if fd == nil {
+			fd = fields.ByJSONName(key)
+		}
+		if fd == nil || raw == nil {
+			continue
+		}
+		if hasRaw && fd.Name() == "raw_data" {
+			continue
+		}
+		if err := decodeTronJSONField(m, fd, raw); err != nil {
+			return fmt.Errorf("%s.%s: %w", desc.Name(), key, err)
+		}
+	}
+	if hasRaw {
+		fd := fields.ByName("raw_data")
+		data, err := hex.DecodeString(rawHex)
+		if err != nil {
+			return fmt.Errorf("invalid raw_data_hex: %w", err)
+		}
+		rawData := m.NewField(fd)
+		if err := proto.Unmarshal(data, rawData.Message().Interface()); err != nil {
+			return fmt.Errorf("invalid raw_data_hex: %w", err)
+		}
+		m.Set(fd, rawData)
+	}
+	return nil
+}
+
+// decodeTronJSONField 依欄位類型寫入 list、map 或單值欄位
+func decodeTronJSONField(m protoreflect.Message, fd protoreflect.FieldDescriptor, raw interface{}) error {
+	switch {
+	case fd.IsList():
+		items, ok := raw.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected array, got %T", raw)
+		}
+		list := m.Mutable(fd).List()
+		for _, item := range items {
+			v, err := tronJSONValue(fd, item, list.NewElement)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+	case fd.IsMap():
+		mp := m.Mutable(fd).Map()
+		set := func(k, v interface{}) error {
+			key, err := tronJSONValue(fd.MapKey(), k, nil)
+			if err != nil {
+				return err
+			}
+			value, err := tronJSONValue(fd.MapValue(), v, mp.NewValue)
+			if err != nil {
+				return err
+			}
+			mp.Set(key.MapKey(), value)
+			return nil
+		}
+		switch entries := raw.(type) {
+		case []interface{}:
+			for _, e := range entries {
+				entry, ok := e.(map[string]interface{})
+				if !ok {
+					return fmt.Errorf("expected map entry, got %T", e)
+				}
+				if err := set(entry["key"], entry["value"]); err != nil {
+					return err
+				}
+			}
+		case map[string]interface{}:
+			for k, v := range entries {
+				if err := set(k, v); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("expected map, got %T", raw)
+		}
+	default:
+		v, err := tronJSONValue(fd, raw, func() protoreflect.Value { return m.NewField(fd) })
+		if err != nil {
+			return err
+		}
+		m.Set(fd, v)
+	}
+	return nil
+}
+
+// tronJSONValue 將 JSON 值轉為欄位值，newMessage 用於建立訊息類型的欄位
+func tronJSONValue(fd protoreflect.FieldDescriptor, raw interface{}, newMessage func() protoreflect.Value) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		switch b := raw.(type) {
+		case bool:
+			return protoreflect.ValueOfBool(b), nil
+		case string:
+			v, err := strconv.ParseBool(b)
+			return protoreflect.ValueOfBool(v), err
+		}
+		return protoreflect.Value{}, fmt.Errorf("expected bool, got %T", raw)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := tronJSONInt(raw, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := tronJSONInt(raw, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(fmt.Sprint(raw), 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(fmt.Sprint(raw), 10, 64)
+		return protoreflect.ValueOfUint64(n), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(fmt.Sprint(raw), 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(fmt.Sprint(raw), 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.StringKind:
+		if s, ok := raw.(string); ok {
+			return protoreflect.ValueOfString(s), nil
+		}
+		return protoreflect.ValueOfString(fmt.Sprint(raw)), nil
+	case protoreflect.BytesKind:
+		s, ok := raw.(string)
+		if !ok {
+			return protoreflect.Value{}, fmt.Errorf("expected hex string, got %T", raw)
+		}
+		return protoreflect.ValueOfBytes(tronJSONBytes(s)), nil
+	case protoreflect.EnumKind:
+		if s, ok := raw.(string); ok {
+			if ev := fd.Enum().Values().ByName(protoreflect.Name(s)); ev != nil {
+				return protoreflect.ValueOfEnum(ev.Number()), nil
+			}
+		}
+		n, err := tronJSONInt(raw, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown %s value %v", fd.Enum().Name(), raw)
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		obj, ok := raw.(map[string]interface{})
+		if !ok {
+			return protoreflect.Value{}, fmt.Errorf("expected object, got %T", raw)
+		}
+		v := newMessage()
+		if err := decodeTronJSON(obj, v.Message()); err != nil {
+			return protoreflect.Value{}, err
+		}
+		return v, nil
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s", fd.Kind())
+}
+
+// decodeTronJSONAny 依 type_url 還原 Any 內的合約訊息
+func decodeTronJSONAny(obj map[string]interface{}, m protoreflect.Message) error {
+	url, _ := obj["type_url"].(string)
+	fields := m.Descriptor().Fields()
+	m.Set(fields.ByName("type_url"), protoreflect.ValueOfString(url))
+	switch value := obj["value"].(type) {
+	case string:
+		m.Set(fields.ByName("value"), protoreflect.ValueOfBytes(tronJSONBytes(value)))
+	case map[string]interface{}:
+		mt, err := protoregistry.GlobalTypes.FindMessageByURL(url)
+		if err != nil {
+			return fmt.Errorf("unknown contract type %q", url)
+		}
+		inner := mt.New()
+		if err := decodeTronJSON(value, inner); err != nil {
+			return err
+		}
+		data, err := proto.Marshal(inner.Interface())
+		if err != nil {
+			return err
+		}
+		m.Set(fields.ByName("value"), protoreflect.ValueOfBytes(data))
+	}
+	return nil
+}
+
+// tronJSONInt 解析 JSON 數字，節點可能以數字或字串回傳 int64
+func tronJSONInt(raw interface{}, bits int) (int64, error) {
+	switch n := raw.(type) {
+	case json.Number:
+		return strconv.ParseInt(n.String(), 10, bits)
+	case float64:
+		return int64(n), nil
+	case string:
+		return strconv.ParseInt(n, 10, bits)
+	}
+	return 0, fmt.Errorf("expected number, got %T", raw)
+}
+
+// tronJSONBytes 解析 hex 字串；非 hex 的內容（如廣播錯誤訊息）保留原文
+func tronJSONBytes(s string) []byte {
+	if b, err := hex.DecodeString(strings.TrimPrefix(s, "0x")); err == nil {
+		return b
+	}
+	return []byte(s)
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"unicode/utf8"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+)
+
+var _ types.MemoTransferer = (*TronClient)(nil)
+
+// SendNativeTokenWithMemo 實作 MemoTransferer 介面，memo 寫入交易 raw_data.data
+// amount 以 sun 計，須為正整數且不超過 int64，與手續費預估的金額檢查相同
+func (t *TronClient) SendNativeTokenWithMemo(ctx context.Context, fromPrivateKey, toAddress string, amount *big.Float, memo string) (string, error) {
+	if t.client == nil {
+		return "", errors.New("Tron client not connected")
+	}
+	if amount == nil {
+		return "", errors.New("amount must be positive")
+	}
+	amt, err := parseSunAmount(amount.Text('f', -1))
+	if err != nil {
+		return "", err
+	}
+	to, err := parseTronAddress(toAddress)
+	if err != nil {
+		return "", err
+	}
+	signer, err := NewTronSigner(fromPrivateKey)
+	if err != nil {
+		return "", err
+	}
+	txn, err := t.client.Transfer(signer.Address().String(), to.String(), amt)
+	if err != nil {
+		return "", err
+	}
+	if err := setTronMemo(txn, memo); err != nil {
+		return "", err
+	}
+	return t.signAndBroadcast(signer, txn)
+}
+
+// setTronMemo 將備註寫入節點建立的交易並重新計算 txid，memo 為空時不修改
+func setTronMemo(ext *api.TransactionExtention, memo string) error {
+	if memo == "" {
+		return nil
+	}
+	if ext.GetTransaction().GetRawData() == nil {
+		return errors.New("transaction has no raw data")
+	}
+	ext.Transaction.RawData.Data = []byte(memo)
+	hash, err := tronTxHash(ext.Transaction)
+	if err != nil {
+		return err
+	}
+	ext.Txid = hash
+	return nil
+}
+
+// tronMemo 解碼交易 raw_data.data，非 UTF-8 內容以 0x 開頭的十六進位回傳
+func tronMemo(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	if utf8.Valid(data) {
+		return string(data)
+	}
+	return "0x" + hex.EncodeToString(data)
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSetTronMemo(t *testing.T) {
+	from := address.HexToAddress("41a614f803b6fd780986a42c78ec9c7f77e6ded13c")
+	tx := testTronTransfer(t, from, from, 1)
+	ext := &api.TransactionExtention{Transaction: tx}
+
+	if err := setTronMemo(ext, ""); err != nil || tx.RawData.Data != nil {
+		t.Fatalf("empty memo must not modify the transaction: %v", err)
+	}
+	if err := setTronMemo(ext, "order-42"); err != nil {
+		t.Fatal(err)
+	}
+	if string(tx.GetRawData().GetData()) != "order-42" {
+		t.Errorf("expected memo in raw_data.data, got %q", tx.GetRawData().GetData())
+	}
+	if hex.EncodeToString(ext.Txid) != getTronTxID(tx) {
+		t.Error("txid was not recomputed after setting the memo")
+	}
+	if err := setTronMemo(&api.TransactionExtention{}, "x"); err == nil {
+		t.Error("expected error for transaction without raw data")
+	}
+}
+
+func TestTronClient_SendNativeTokenRejectsInvalidAmount(t *testing.T) {
+	addr, _ := testGRPCServer(t)
+	c := &TronClient{}
+	if err := c.Connect(context.Background(), addr); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	key, _ := crypto.GenerateKey()
+	privateKey := hex.EncodeToString(crypto.FromECDSA(key))
+	// 負數、小數與超過 int64 的金額須在建立交易前拒絕，不可截斷或溢位
+	for _, amount := range []*big.Float{big.NewFloat(-1), big.NewFloat(0), big.NewFloat(0.5), big.NewFloat(1e30), nil} {
+		_, err := c.SendNativeTokenWithMemo(context.Background(), privateKey, "TVjsyZ7fYF3qLF6BQgPmTEZy1xrNNyVAAA", amount, "")
+		if err == nil || !strings.Contains(err.Error(), "amount") {
+			t.Errorf("amount %v: expected amount error, got %v", amount, err)
+		}
+	}
+}
+
+func TestTronMemo(t *testing.T) {
+	for data, want := range map[string]string{
+		"":             "",
+		"order-42":     "order-42",
+		"充值":           "充值",
+		"\xff\xfe\x00": "0xfffe00",
+	} {
+		if got := tronMemo([]byte(data)); got != want {
+			t.Errorf("tronMemo(%q) = %q, want %q", data, got, want)
+		}
+	}
+}
+
+func TestTronClient_MemoTransferAndDeposit(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	from := address.PubkeyToAddress(key.PublicKey)
+	to := address.HexToAddress("41e552f6487585c2b58bc2c9bb4492bc1f17132cd0")
+
+	created := testTronTransfer(t, from, to, 5)
+	createdJSON := tronJSONMessage(created.ProtoReflect())
+	createdJSON["txID"] = getTronTxID(created)
+
+	// 區塊內附帶備註的入帳交易
+	deposit := testTronTransfer(t, from, to, 9)
+	deposit.RawData.Data = []byte("uid:1001")
+	depositJSON := tronJSONMessage(deposit.ProtoReflect())
+	depositJSON["txID"] = getTronTxID(deposit)
+	block := map[string]interface{}{
+		"blockID":      hex.EncodeToString(make([]byte, 32)),
+		"block_header": map[string]interface{}{"raw_data": map[string]interface{}{"number": 100}},
+		"transactions": []interface{}{depositJSON},
+	}
+
+	var broadcast *core.Transaction
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]interface{}
+		_ = json.Unmarshal(body, &req)
+		var resp interface{}
+		switch r.URL.Path {
+		case "/wallet/createtransaction":
+			resp = createdJSON
+		case "/wallet/broadcasthex":
+			data, _ := hex.DecodeString(req["transaction"].(string))
+			broadcast = new(core.Transaction)
+			_ = proto.Unmarshal(data, broadcast)
+			resp = map[string]interface{}{"result": true}
+		case "/wallet/getblockbynum":
+			resp = block
+		case "/wallet/gettransactionbyid":
+			resp = depositJSON
+		case "/wallet/gettransactioninfobyid":
+			resp = map[string]interface{}{"id": depositJSON["txID"], "blockNumber": 100}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	c := &TronClient{}
+	if err := c.Connect(context.Background(), srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	txid, err := c.SendNativeTokenWithMemo(context.Background(), hex.EncodeToString(crypto.FromECDSA(key)), to.String(), big.NewFloat(5), "order-42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if broadcast == nil || string(broadcast.GetRawData().GetData()) != "order-42" {
+		t.Fatalf("expected memo in broadcast transaction, got %v", broadcast)
+	}
+	if txid != getTronTxID(broadcast) || txid == createdJSON["txID"] {
+		t.Errorf("txid %s does not cover the memo", txid)
+	}
+
+	watched := newWatchSet()
+	watched.add(to.String())
+	_, deposits, err := c.scanDepositBlock(context.Background(), 100, watched, newWatchSet())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deposits) != 1 || deposits[0].Memo != "uid:1001" || deposits[0].Amount != "9" {
+		t.Errorf("expected one deposit with memo, got %+v", deposits)
+	}
+
+	detail, err := c.GetTransaction(context.Background(), getTronTxID(deposit))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detail.Memo != "uid:1001" {
+		t.Errorf("expected memo in transaction detail, got %q", detail.Memo)
+	}
+}
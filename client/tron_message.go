@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+)
+
+var _ types.MessageSigner = (*TronClient)(nil)
+
+// SignMessage 實作 MessageSigner 介面，與 TronWeb signMessageV2 相容，不需連線節點
+func (t *TronClient) SignMessage(ctx context.Context, privateKey, message string) (types.MessageSignatureResponse, error) {
+	sig, pub, err := signPrefixedMessage(tronMessagePrefix, privateKey, message)
+	if err != nil {
+		return types.MessageSignatureResponse{}, err
+	}
+	return types.MessageSignatureResponse{Address: address.PubkeyToAddress(*pub).String(), Signature: sig}, nil
+}
+
+// VerifyMessage 實作 MessageSigner 介面，與 TronWeb verifyMessageV2 相同回傳 base58 地址
+func (t *TronClient) VerifyMessage(ctx context.Context, message, signature string) (string, error) {
+	pub, err := recoverPrefixedMessage(tronMessagePrefix, message, signature)
+	if err != nil {
+		return "", err
+	}
+	return address.PubkeyToAddress(*pub).String(), nil
+}
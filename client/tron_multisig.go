@@ -0,0 +1,386 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"github.com/golang/protobuf/proto"
+)
+
+var _ types.TronMultiSigManager = (*TronClient)(nil)
+
+const (
+	// tronMaxPermissionKeys 單一權限最多的簽名地址數
+	tronMaxPermissionKeys = 5
+	// tronMaxActivePermissions 最多的 active 權限數
+	tronMaxActivePermissions = 8
+	// tronActivePermissionID 第一個 active 權限的 ID，owner 為 0、witness 為 1
+	tronActivePermissionID = 2
+	// defaultMultiSigExpiration 多簽交易預設有效時間，需預留收集簽名的時間
+	defaultMultiSigExpiration = time.Hour
+	// tronMaxExpiration 節點接受的最長交易有效時間
+	tronMaxExpiration = 24 * time.Hour
+)
+
+// defaultActiveOperations active 權限未指定 operations 時允許的合約類型
+// 涵蓋本 SDK 提供的轉帳、合約、質押與投票操作，不包含權限更新
+var defaultActiveOperations = []core.Transaction_Contract_ContractType{
+	core.Transaction_Contract_AccountCreateContract,
+	core.Transaction_Contract_TransferContract,
+	core.Transaction_Contract_TransferAssetContract,
+	core.Transaction_Contract_VoteWitnessContract,
+	core.Transaction_Contract_AccountUpdateContract,
+	core.Transaction_Contract_WithdrawBalanceContract,
+	core.Transaction_Contract_CreateSmartContract,
+	core.Transaction_Contract_TriggerSmartContract,
+	core.Transaction_Contract_UpdateSettingContract,
+	core.Transaction_Contract_UpdateEnergyLimitContract,
+	core.Transaction_Contract_FreezeBalanceV2Contract,
+	core.Transaction_Contract_UnfreezeBalanceV2Contract,
+	core.Transaction_Contract_WithdrawExpireUnfreezeContract,
+	core.Transaction_Contract_DelegateResourceContract,
+	core.Transaction_Contract_UnDelegateResourceContract,
+}
+
+// UpdateAccountPermission 實作 TronMultiSigManager 介面
+// 權限更新會燃燒鏈參數 getUpdateAccountPermissionFee 設定的 TRX
+func (t *TronClient) UpdateAccountPermission(ctx context.Context, privateKey string, owner types.TronPermission, actives []types.TronPermission) (string, error) {
+	if t.client == nil {
+		return "", errors.New("Tron client not connected")
+	}
+	if len(actives) == 0 || len(actives) > tronMaxActivePermissions {
+		return "", fmt.Errorf("expected 1 to %d active permissions, got %d", tronMaxActivePermissions, len(actives))
+	}
+	signer, err := NewTronSigner(privateKey)
+	if err != nil {
+		return "", err
+	}
+	contract := &core.AccountPermissionUpdateContract{OwnerAddress: signer.Address().Bytes()}
+	if contract.Owner, err = tronPermission(owner, core.Permission_Owner, 0); err != nil {
+		return "", fmt.Errorf("owner permission: %w", err)
+	}
+	for i, active := range actives {
+		p, err := tronPermission(active, core.Permission_Active, int32(tronActivePermissionID+i))
+		if err != nil {
+			return "", fmt.Errorf("active permission %d: %w", i, err)
+		}
+		contract.Actives = append(contract.Actives, p)
+	}
+	ext, err := t.client.Client.AccountPermissionUpdate(ctx, contract)
+	if err != nil {
+		return "", err
+	}
+	if code := ext.GetResult().GetCode(); code != api.Return_SUCCESS {
+		return "", fmt.Errorf("%s: %s", code, ext.GetResult().GetMessage())
+	}
+	return t.signAndBroadcast(signer, ext)
+}
+
+// CreateMultiSigTransaction 實作 TronMultiSigManager 介面
+// 由節點建立轉帳交易後設定 permission_id 並延長有效時間，回傳未簽名交易
+// permission_id 須為 owner 或帳戶現有的 active 權限；有效時間自 ref block 時間起算，不受本機時鐘影響
+func (t *TronClient) CreateMultiSigTransaction(ctx context.Context, req types.TronMultiSigCreateRequest) (types.TronMultiSigTransactionResponse, error) {
+	if t.client == nil {
+		return types.TronMultiSigTransactionResponse{}, errors.New("Tron client not connected")
+	}
+	owner, err := parseTronAddress(req.OwnerAddress)
+	if err != nil {
+		return types.TronMultiSigTransactionResponse{}, err
+	}
+	to, err := parseTronAddress(req.ToAddress)
+	if err != nil {
+		return types.TronMultiSigTransactionResponse{}, err
+	}
+	amount, err := parseSmallestUnit(req.Amount)
+	if err != nil {
+		return types.TronMultiSigTransactionResponse{}, err
+	}
+	if err := t.checkTransferPermission(ctx, owner, req.PermissionID); err != nil {
+		return types.TronMultiSigTransactionResponse{}, err
+	}
+	expiration := defaultMultiSigExpiration
+	if req.ExpirationSeconds > 0 {
+		expiration = time.Duration(req.ExpirationSeconds) * time.Second
+	}
+	if expiration > tronMaxExpiration {
+		return types.TronMultiSigTransactionResponse{}, fmt.Errorf("expiration must not exceed %s", tronMaxExpiration)
+	}
+
+	ref, err := t.GetRefBlock(ctx)
+	if err != nil {
+		return types.TronMultiSigTransactionResponse{}, err
+	}
+	refBytes, refHash, err := tronRefBlock(ref)
+	if err != nil {
+		return types.TronMultiSigTransactionResponse{}, err
+	}
+
+	var ext *api.TransactionExtention
+	if req.ContractAddress == "" {
+		if !amount.IsInt64() {
+			return types.TronMultiSigTransactionResponse{}, fmt.Errorf("amount %s overflows int64", req.Amount)
+		}
+		if ext, err = t.client.Transfer(owner.String(), to.String(), amount.Int64()); err != nil {
+			return types.TronMultiSigTransactionResponse{}, err
+		}
+	} else {
+		contract, err := parseTronAddress(req.ContractAddress)
+		if err != nil {
+			return types.TronMultiSigTransactionResponse{}, err
+		}
+		data, err := erc20ABI.Pack("transfer", tronToEVMAddress(to), amount)
+		if err != nil {
+			return types.TronMultiSigTransactionResponse{}, err
+		}
+		if ext, err = t.client.TRC20Call(owner.String(), contract.String(), hex.EncodeToString(data), false, t.feeLimit()); err != nil {
+			return types.TronMultiSigTransactionResponse{}, err
+		}
+	}
+	if code := ext.GetResult().GetCode(); code != api.Return_SUCCESS {
+		return types.TronMultiSigTransactionResponse{}, fmt.Errorf("%s: %s", code, ext.GetResult().GetMessage())
+	}
+	tx := ext.GetTransaction()
+	if err := prepareMultiSig(tx, req.PermissionID, time.UnixMilli(ref.Timestamp).Add(expiration)); err != nil {
+		return types.TronMultiSigTransactionResponse{}, err
+	}
+	// 改以查詢到的區塊為 ref block，使有效時間與 ref block 一致
+	tx.RawData.RefBlockBytes, tx.RawData.RefBlockHash = refBytes, refHash
+	return multiSigResponse(tx, nil)
+}
+
+// checkTransferPermission 檢查權限可用於轉帳：owner（0）或帳戶現有的 active 權限，witness 權限僅能產生區塊
+func (t *TronClient) checkTransferPermission(ctx context.Context, owner address.Address, permissionID int32) error {
+	if permissionID == 0 {
+		return nil
+	}
+	if permissionID < tronActivePermissionID {
+		return fmt.Errorf("%w: permission id %d", ErrUnsupportedPermission, permissionID)
+	}
+	acc, err := t.client.Client.GetAccount(ctx, &core.Account{Address: owner.Bytes()})
+	if err != nil {
+		return err
+	}
+	for _, p := range acc.GetActivePermission() {
+		if p.GetId() == permissionID {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: account %s has no active permission %d", ErrUnsupportedPermission, owner.String(), permissionID)
+}
+
+// SignMultiSigTransaction 實作 TronMultiSigManager 介面
+// 簽名後向節點查詢權重，簽名者不在交易權限中時回傳錯誤
+func (t *TronClient) SignMultiSigTransaction(ctx context.Context, privateKey, transaction string) (types.TronMultiSigTransactionResponse, error) {
+	if t.client == nil {
+		return types.TronMultiSigTransactionResponse{}, errors.New("Tron client not connected")
+	}
+	tx, err := decodeTronTransaction(transaction)
+	if err != nil {
+		return types.TronMultiSigTransactionResponse{}, err
+	}
+	signer, err := NewTronSigner(privateKey)
+	if err != nil {
+		return types.TronMultiSigTransactionResponse{}, err
+	}
+	if _, err := signer.Sign(tx); err != nil {
+		return types.TronMultiSigTransactionResponse{}, err
+	}
+	weight, err := t.signWeight(ctx, tx)
+	if err != nil {
+		return types.TronMultiSigTransactionResponse{}, err
+	}
+	if !weight.Enough && weight.Result != api.TransactionSignWeight_Result_NOT_ENOUGH_PERMISSION.String() {
+		return types.TronMultiSigTransactionResponse{}, fmt.Errorf("%s: %s", weight.Result, weight.Message)
+	}
+	return multiSigResponse(tx, &weight)
+}
+
+// GetTransactionSignWeight 實作 TronMultiSigManager 介面
+func (t *TronClient) GetTransactionSignWeight(ctx context.Context, transaction string) (types.TronSignWeightResponse, error) {
+	if t.client == nil {
+		return types.TronSignWeightResponse{}, errors.New("Tron client not connected")
+	}
+	tx, err := decodeTronTransaction(transaction)
+	if err != nil {
+		return types.TronSignWeightResponse{}, err
+	}
+	return t.signWeight(ctx, tx)
+}
+
+// BroadcastMultiSigTransaction 實作 TronMultiSigManager 介面
+func (t *TronClient) BroadcastMultiSigTransaction(ctx context.Context, transaction string) (string, error) {
+	if t.client == nil {
+		return "", errors.New("Tron client not connected")
+	}
+	tx, err := decodeTronTransaction(transaction)
+	if err != nil {
+		return "", err
+	}
+	weight, err := t.signWeight(ctx, tx)
+	if err != nil {
+		return "", err
+	}
+	if !weight.Enough {
+		return "", fmt.Errorf("signature weight %d has not reached threshold %d (%s)", weight.CurrentWeight, weight.Threshold, weight.Result)
+	}
+	if _, err := t.client.Broadcast(tx); err != nil {
+		return "", err
+	}
+	return getTronTxID(tx), nil
+}
+
+// signWeight 向節點查詢交易的簽名權重
+func (t *TronClient) signWeight(ctx context.Context, tx *core.Transaction) (types.TronSignWeightResponse, error) {
+	weight, err := t.client.Client.GetTransactionSignWeight(ctx, tx)
+	if err != nil {
+		return types.TronSignWeightResponse{}, err
+	}
+	return tronSignWeight(weight), nil
+}
+
+// tronSignWeight 轉換節點回傳的簽名權重
+func tronSignWeight(w *api.TransactionSignWeight) types.TronSignWeightResponse {
+	approved := make([]string, 0, len(w.GetApprovedList()))
+	for _, a := range w.GetApprovedList() {
+		approved = append(approved, address.Address(a).String())
+	}
+	return types.TronSignWeightResponse{
+		PermissionID:      w.GetPermission().GetId(),
+		PermissionName:    w.GetPermission().GetPermissionName(),
+		Threshold:         w.GetPermission().GetThreshold(),
+		CurrentWeight:     w.GetCurrentWeight(),
+		ApprovedAddresses: approved,
+		Enough:            w.GetResult().GetCode() == api.TransactionSignWeight_Result_ENOUGH_PERMISSION,
+		Result:            w.GetResult().GetCode().String(),
+		Message:           w.GetResult().GetMessage(),
+	}
+}
+
+// tronPermission 將 API 權限設定轉為鏈上 Permission，檢查簽名地址與門檻
+func tronPermission(p types.TronPermission, typ core.Permission_PermissionType, id int32) (*core.Permission, error) {
+	if p.Threshold <= 0 {
+		return nil, errors.New("threshold must be positive")
+	}
+	if len(p.Keys) == 0 || len(p.Keys) > tronMaxPermissionKeys {
+		return nil, fmt.Errorf("expected 1 to %d keys, got %d", tronMaxPermissionKeys, len(p.Keys))
+	}
+	perm := &core.Permission{
+		Type:           typ,
+		Id:             id,
+		PermissionName: p.Name,
+		Threshold:      p.Threshold,
+	}
+	if perm.PermissionName == "" {
+		perm.PermissionName = strings.ToLower(typ.String())
+	}
+	seen := make(map[string]bool, len(p.Keys))
+	var total int64
+	for _, k := range p.Keys {
+		addr, err := parseTronAddress(k.Address)
+		if err != nil {
+			return nil, err
+		}
+		if seen[addr.String()] {
+			return nil, fmt.Errorf("duplicate key %s", addr.String())
+		}
+		seen[addr.String()] = true
+		if k.Weight <= 0 {
+			return nil, fmt.Errorf("key %s: weight must be positive", addr.String())
+		}
+		total += k.Weight
+		perm.Keys = append(perm.Keys, &core.Key{Address: addr.Bytes(), Weight: k.Weight})
+	}
+	if total < p.Threshold {
+		return nil, fmt.Errorf("sum of key weights %d is below threshold %d", total, p.Threshold)
+	}
+	if typ == core.Permission_Active {
+		ops, err := tronOperations(p.Operations)
+		if err != nil {
+			return nil, err
+		}
+		perm.Operations = ops
+	}
+	return perm, nil
+}
+
+// tronOperations 將合約類型名稱編碼為 32 bytes 的 operations 位元圖，第 n 位代表合約類型 n
+func tronOperations(names []string) ([]byte, error) {
+	ops := make([]byte, 32)
+	if len(names) == 0 {
+		for _, c := range defaultActiveOperations {
+			ops[c/8] |= 1 << (c % 8)
+		}
+		return ops, nil
+	}
+	for _, name := range names {
+		v, ok := core.Transaction_Contract_ContractType_value[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown contract type %q", name)
+		}
+		ops[v/8] |= 1 << (v % 8)
+	}
+	return ops, nil
+}
+
+// prepareMultiSig 設定交易使用的權限與過期時間，須在任何簽名之前呼叫
+func prepareMultiSig(tx *core.Transaction, permissionID int32, expiration time.Time) error {
+	if tx.GetRawData() == nil || len(tx.GetRawData().GetContract()) == 0 {
+		return errors.New("transaction has no contract")
+	}
+	if len(tx.GetSignature()) > 0 {
+		return errors.New("transaction is already signed")
+	}
+	for _, c := range tx.RawData.Contract {
+		c.PermissionId = permissionID
+	}
+	tx.RawData.Expiration = expiration.UnixMilli()
+	return nil
+}
+
+// multiSigResponse 編碼交易供下一位簽名者使用
+func multiSigResponse(tx *core.Transaction, weight *types.TronSignWeightResponse) (types.TronMultiSigTransactionResponse, error) {
+	encoded, err := encodeTronTransaction(tx)
+	if err != nil {
+		return types.TronMultiSigTransactionResponse{}, err
+	}
+	return types.TronMultiSigTransactionResponse{
+		TxID:         getTronTxID(tx),
+		Transaction:  encoded,
+		PermissionID: tx.GetRawData().GetContract()[0].GetPermissionId(),
+		Expiration:   tx.GetRawData().GetExpiration(),
+		SignWeight:   weight,
+	}, nil
+}
+
+// encodeTronTransaction 將交易編碼為十六進位 protobuf
+func encodeTronTransaction(tx *core.Transaction) (string, error) {
+	b, err := proto.Marshal(tx)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// decodeTronTransaction 解碼十六進位 protobuf 交易
+func decodeTronTransaction(s string) (*core.Transaction, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(s), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction hex: %w", err)
+	}
+	tx := new(core.Transaction)
+	if err := proto.Unmarshal(b, tx); err != nil {
+		return nil, fmt.Errorf("invalid transaction: %w", err)
+	}
+	if tx.GetRawData() == nil || len(tx.GetRawData().GetContract()) == 0 {
+		return nil, errors.New("transaction has no contract")
+	}
+	return tx, nil
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+)
+
+func TestTronOperations(t *testing.T) {
+	ops, err := tronOperations([]string{"TransferContract", "TriggerSmartContract"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// TransferContract = 1, TriggerSmartContract = 31
+	want := make([]byte, 32)
+	want[0] = 0x02
+	want[3] = 0x80
+	if hex.EncodeToString(ops) != hex.EncodeToString(want) {
+		t.Errorf("unexpected operations %x", ops)
+	}
+	if _, err := tronOperations([]string{"NoSuchContract"}); err == nil {
+		t.Error("expected error for unknown contract type")
+	}
+	ops, _ = tronOperations(nil)
+	if ops[core.Transaction_Contract_AccountPermissionUpdateContract/8]&(1<<(core.Transaction_Contract_AccountPermissionUpdateContract%8)) != 0 {
+		t.Error("default operations must not allow permission updates")
+	}
+}
+
+func TestTronPermission(t *testing.T) {
+	a := "TJRabPrwbZy45sbavfcjinPJC18kjpRTv8"
+	b := "TVjsyZ7fYF3qLF6BQgPmTEZy1xrNNyVAAA"
+	perm, err := tronPermission(types.TronPermission{
+		Threshold: 2,
+		Keys:      []types.TronPermissionKey{{Address: a, Weight: 1}, {Address: b, Weight: 1}},
+	}, core.Permission_Active, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm.Id != 2 || perm.PermissionName != "active" || len(perm.Keys) != 2 || len(perm.Operations) != 32 {
+		t.Errorf("unexpected permission %+v", perm)
+	}
+
+	for name, p := range map[string]types.TronPermission{
+		"threshold above weights": {Threshold: 3, Keys: []types.TronPermissionKey{{Address: a, Weight: 1}, {Address: b, Weight: 1}}},
+		"duplicate key":           {Threshold: 1, Keys: []types.TronPermissionKey{{Address: a, Weight: 1}, {Address: a, Weight: 1}}},
+		"zero weight":             {Threshold: 1, Keys: []types.TronPermissionKey{{Address: a, Weight: 0}, {Address: b, Weight: 1}}},
+		"no keys":                 {Threshold: 1},
+	} {
+		if _, err := tronPermission(p, core.Permission_Owner, 0); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestPrepareMultiSig(t *testing.T) {
+	tx := testTronTransaction()
+	tx.RawData.Contract = []*core.Transaction_Contract{{Type: core.Transaction_Contract_TransferContract}}
+	before := getTronTxID(tx)
+	expiration := time.UnixMilli(1700003600000)
+	if err := prepareMultiSig(tx, 2, expiration); err != nil {
+		t.Fatal(err)
+	}
+	if tx.RawData.Contract[0].PermissionId != 2 || tx.RawData.Expiration != expiration.UnixMilli() {
+		t.Errorf("unexpected raw data %+v", tx.RawData)
+	}
+	if getTronTxID(tx) == before {
+		t.Error("txid must change with permission id")
+	}
+
+	key, _ := crypto.GenerateKey()
+	signer, _ := NewTronSigner(hex.EncodeToString(crypto.FromECDSA(key)))
+	_, _ = signer.Sign(tx)
+	if err := prepareMultiSig(tx, 3, expiration); err == nil {
+		t.Error("expected error for signed transaction")
+	}
+}
+
+func TestTronTransactionEncoding(t *testing.T) {
+	tx := testTronTransaction()
+	tx.RawData.Contract = []*core.Transaction_Contract{{Type: core.Transaction_Contract_TransferContract, PermissionId: 2}}
+	tx.Signature = [][]byte{make([]byte, 65)}
+	encoded, err := encodeTronTransaction(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := decodeTronTransaction("0x" + encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if getTronTxID(decoded) != getTronTxID(tx) || len(decoded.Signature) != 1 {
+		t.Errorf("round trip mismatch: %+v", decoded)
+	}
+	if _, err := decodeTronTransaction("zz"); err == nil {
+		t.Error("expected error for invalid hex")
+	}
+}
+
+func TestTronClient_CreateMultiSigTransaction(t *testing.T) {
+	owner, _ := address.Base58ToAddress("TJRabPrwbZy45sbavfcjinPJC18kjpRTv8")
+	to, _ := address.Base58ToAddress("TVjsyZ7fYF3qLF6BQgPmTEZy1xrNNyVAAA")
+	created := testTronTransfer(t, owner, to, 5)
+	createdJSON := tronJSONMessage(created.ProtoReflect())
+	createdJSON["txID"] = getTronTxID(created)
+
+	account := &core.Account{
+		Address:           owner,
+		OwnerPermission:   &core.Permission{Type: core.Permission_Owner, Threshold: 1},
+		WitnessPermission: &core.Permission{Type: core.Permission_Witness, Id: 1, Threshold: 1},
+		ActivePermission:  []*core.Permission{{Type: core.Permission_Active, Id: 2, Threshold: 2}},
+	}
+	// ref block 時間與本機時鐘相差一天，有效時間須依 ref block 計算
+	refTime := time.Now().Add(-24 * time.Hour).UnixMilli()
+	blockID := make([]byte, 32)
+	binary.BigEndian.PutUint64(blockID, 0x1234)
+	blockID[8] = 0xab
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp interface{}
+		switch r.URL.Path {
+		case "/wallet/getaccount":
+			resp = tronJSONMessage(account.ProtoReflect())
+		case "/wallet/getnowblock":
+			resp = map[string]interface{}{
+				"blockID":      hex.EncodeToString(blockID),
+				"block_header": map[string]interface{}{"raw_data": map[string]interface{}{"number": 0x1234, "timestamp": refTime}},
+			}
+		case "/wallet/createtransaction":
+			resp = createdJSON
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+	c := &TronClient{}
+	if err := c.Connect(context.Background(), srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	req := types.TronMultiSigCreateRequest{OwnerAddress: owner.String(), ToAddress: to.String(), Amount: "5", PermissionID: 2}
+	resp, err := c.CreateMultiSigTransaction(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := refTime + defaultMultiSigExpiration.Milliseconds(); resp.Expiration != want {
+		t.Errorf("expected expiration %d from ref block time, got %d", want, resp.Expiration)
+	}
+	tx, err := decodeTronTransaction(resp.Transaction)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(tx.RawData.RefBlockBytes) != "1234" || hex.EncodeToString(tx.RawData.RefBlockHash) != hex.EncodeToString(blockID[8:16]) {
+		t.Errorf("unexpected ref block %x %x", tx.RawData.RefBlockBytes, tx.RawData.RefBlockHash)
+	}
+
+	// witness 權限與帳戶不存在的 active 權限不可用於轉帳
+	for _, id := range []int32{1, 3, -1} {
+		req.PermissionID = id
+		if _, err := c.CreateMultiSigTransaction(context.Background(), req); !errors.Is(err, ErrUnsupportedPermission) {
+			t.Errorf("permission %d: expected ErrUnsupportedPermission, got %v", id, err)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var _ types.TronOfflineBuilder = (*TronClient)(nil)
+
+// defaultOfflineExpiration 離線交易預設有效時間，需預留搬運至離線環境簽名的時間
+const defaultOfflineExpiration = time.Hour
+
+// GetRefBlock 實作 TronOfflineBuilder 介面
+// 快照須在簽名前取得並帶到離線環境，交易須在該區塊之後 65535 個區塊（約 54 小時）內上鏈
+func (t *TronClient) GetRefBlock(ctx context.Context) (types.TronRefBlock, error) {
+	if t.client == nil {
+		return types.TronRefBlock{}, errors.New("Tron client not connected")
+	}
+	block, err := t.client.GetNowBlock()
+	if err != nil {
+		return types.TronRefBlock{}, err
+	}
+	header := block.GetBlockHeader().GetRawData()
+	if len(block.GetBlockid()) != 32 || header == nil {
+		return types.TronRefBlock{}, errors.New("node returned no block id")
+	}
+	return types.TronRefBlock{
+		Number:    header.GetNumber(),
+		Hash:      hex.EncodeToString(block.GetBlockid()),
+		Timestamp: header.GetTimestamp(),
+	}, nil
+}
+
+// BuildOfflineTransaction 實作 TronOfflineBuilder 介面
+// 不連線節點，ref_block_bytes、ref_block_hash 與 expiration 皆由 ref block 快照計算
+func (t *TronClient) BuildOfflineTransaction(req types.TronOfflineBuildRequest) (types.TronOfflineTransaction, error) {
+	refBytes, refHash, err := tronRefBlock(req.RefBlock)
+	if err != nil {
+		return types.TronOfflineTransaction{}, err
+	}
+	expiration := defaultOfflineExpiration
+	if req.ExpirationSeconds > 0 {
+		expiration = time.Duration(req.ExpirationSeconds) * time.Second
+	}
+	if expiration > tronMaxExpiration {
+		return types.TronOfflineTransaction{}, fmt.Errorf("expiration must not exceed %s", tronMaxExpiration)
+	}
+	owner, err := parseTronAddress(req.OwnerAddress)
+	if err != nil {
+		return types.TronOfflineTransaction{}, err
+	}
+	to, err := parseTronAddress(req.ToAddress)
+	if err != nil {
+		return types.TronOfflineTransaction{}, err
+	}
+	amount, ok := new(big.Int).SetString(req.Amount, 10)
+	if !ok || amount.Sign() <= 0 {
+		return types.TronOfflineTransaction{}, fmt.Errorf("invalid amount %q", req.Amount)
+	}
+
+	raw := &core.TransactionRaw{
+		RefBlockBytes: refBytes,
+		RefBlockHash:  refHash,
+		Expiration:    req.RefBlock.Timestamp + expiration.Milliseconds(),
+		Timestamp:     time.Now().UnixMilli(),
+		Data:          []byte(req.Memo),
+	}
+	var (
+		contract proto.Message
+		typ      core.Transaction_Contract_ContractType
+	)
+	if req.ContractAddress == "" {
+		if !amount.IsInt64() {
+			return types.TronOfflineTransaction{}, fmt.Errorf("amount %s overflows int64", req.Amount)
+		}
+		contract = &core.TransferContract{OwnerAddress: owner.Bytes(), ToAddress: to.Bytes(), Amount: amount.Int64()}
+		typ = core.Transaction_Contract_TransferContract
+	} else {
+		token, err := parseTronAddress(req.ContractAddress)
+		if err != nil {
+			return types.TronOfflineTransaction{}, err
+		}
+		data, err := erc20ABI.Pack("transfer", tronToEVMAddress(to), amount)
+		if err != nil {
+			return types.TronOfflineTransaction{}, err
+		}
+		contract = &core.TriggerSmartContract{OwnerAddress: owner.Bytes(), ContractAddress: token.Bytes(), Data: data}
+		typ = core.Transaction_Contract_TriggerSmartContract
+		raw.FeeLimit = req.FeeLimit
+		if raw.FeeLimit <= 0 {
+			raw.FeeLimit = t.feeLimit()
+		}
+	}
+	param, err := anypb.New(contract)
+	if err != nil {
+		return types.TronOfflineTransaction{}, err
+	}
+	raw.Contract = []*core.Transaction_Contract{{Type: typ, Parameter: param}}
+	return tronOfflineTransaction(&core.Transaction{RawData: raw})
+}
+
+// SignOfflineTransaction 實作 TronOfflineBuilder 介面
+// 不連線節點，已過期的交易拒絕簽名；多簽時可依序以不同私鑰呼叫
+func (t *TronClient) SignOfflineTransaction(privateKey string, tx types.TronOfflineTransaction) (types.TronOfflineTransaction, error) {
+	decoded, err := parseTronOfflineTransaction(tx)
+	if err != nil {
+		return types.TronOfflineTransaction{}, err
+	}
+	if expiration := decoded.GetRawData().GetExpiration(); expiration <= time.Now().UnixMilli() {
+		return types.TronOfflineTransaction{}, fmt.Errorf("transaction expired at %s", time.UnixMilli(expiration).UTC().Format(time.RFC3339))
+	}
+	signer, err := NewTronSigner(privateKey)
+	if err != nil {
+		return types.TronOfflineTransaction{}, err
+	}
+	if _, err := signer.Sign(decoded); err != nil {
+		return types.TronOfflineTransaction{}, err
+	}
+	return tronOfflineTransaction(decoded)
+}
+
+// BroadcastOfflineTransaction 實作 TronOfflineBuilder 介面
+func (t *TronClient) BroadcastOfflineTransaction(ctx context.Context, tx types.TronOfflineTransaction) (string, error) {
+	if t.client == nil {
+		return "", errors.New("Tron client not connected")
+	}
+	decoded, err := parseTronOfflineTransaction(tx)
+	if err != nil {
+		return "", err
+	}
+	if len(decoded.GetSignature()) == 0 {
+		return "", errors.New("transaction is not signed")
+	}
+	if _, err := t.client.Broadcast(decoded); err != nil {
+		return "", err
+	}
+	return getTronTxID(decoded), nil
+}
+
+// tronRefBlock 由區塊快照計算 ref_block_bytes（高度的第 6、7 byte）與 ref_block_hash（區塊 ID 的第 8 至 15 byte）
+// 波場區塊 ID 的前 8 bytes 為區塊高度，藉此檢查高度與 ID 是否來自同一區塊
+func tronRefBlock(ref types.TronRefBlock) ([]byte, []byte, error) {
+	id, err := hex.DecodeString(strings.TrimPrefix(ref.Hash, "0x"))
+	if err != nil || len(id) != 32 {
+		return nil, nil, fmt.Errorf("invalid ref block hash %q", ref.Hash)
+	}
+	if ref.Number <= 0 || ref.Timestamp <= 0 {
+		return nil, nil, errors.New("ref block number and timestamp are required")
+	}
+	number := make([]byte, 8)
+	binary.BigEndian.PutUint64(number, uint64(ref.Number))
+	if !bytes.Equal(number, id[:8]) {
+		return nil, nil, fmt.Errorf("ref block hash %s does not belong to block %d", ref.Hash, ref.Number)
+	}
+	return number[6:8], id[8:16], nil
+}
+
+// tronOfflineTransaction 將交易轉為可攜式 JSON，raw_data 與 TronGrid 格式相同
+func tronOfflineTransaction(tx *core.Transaction) (types.TronOfflineTransaction, error) {
+	raw, err := proto.Marshal(tx.GetRawData())
+	if err != nil {
+		return types.TronOfflineTransaction{}, err
+	}
+	rawJSON, err := json.Marshal(tronJSONMessage(tx.GetRawData().ProtoReflect()))
+	if err != nil {
+		return types.TronOfflineTransaction{}, err
+	}
+	hash := sha256.Sum256(raw)
+	out := types.TronOfflineTransaction{
+		TxID:       hex.EncodeToString(hash[:]),
+		RawData:    rawJSON,
+		RawDataHex: hex.EncodeToString(raw),
+	}
+	for _, sig := range tx.GetSignature() {
+		out.Signature = append(out.Signature, hex.EncodeToString(sig))
+	}
+	return out, nil
+}
+
+// parseTronOfflineTransaction 以 raw_data_hex 還原交易
+// txID 與 raw_data 為選填，提供時須與 raw_data_hex 一致，避免簽到與檢視內容不同的交易
+func parseTronOfflineTransaction(tx types.TronOfflineTransaction) (*core.Transaction, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(tx.RawDataHex, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid raw_data_hex: %w", err)
+	}
+	raw := new(core.TransactionRaw)
+	if err := proto.Unmarshal(data, raw); err != nil {
+		return nil, fmt.Errorf("invalid raw_data_hex: %w", err)
+	}
+	if len(raw.GetContract()) == 0 {
+		return nil, errors.New("transaction has no contract")
+	}
+	hash := sha256.Sum256(data)
+	if tx.TxID != "" && !strings.EqualFold(tx.TxID, hex.EncodeToString(hash[:])) {
+		return nil, fmt.Errorf("txID %s does not match raw_data_hex", tx.TxID)
+	}
+	if len(tx.RawData) > 0 && string(tx.RawData) != "null" {
+		dec := json.NewDecoder(bytes.NewReader(tx.RawData))
+		dec.UseNumber()
+		var obj map[string]interface{}
+		if err := dec.Decode(&obj); err != nil {
+			return nil, fmt.Errorf("invalid raw_data: %w", err)
+		}
+		view := new(core.TransactionRaw)
+		if err := decodeTronJSON(obj, view.ProtoReflect()); err != nil {
+			return nil, fmt.Errorf("invalid raw_data: %w", err)
+		}
+		if !proto.Equal(view, raw) {
+			return nil, errors.New("raw_data does not match raw_data_hex")
+		}
+	}
+	decoded := &core.Transaction{RawData: raw}
+	for _, sig := range tx.Signature {
+		b, err := hex.DecodeString(strings.TrimPrefix(sig, "0x"))
+		if err != nil || len(b) != 65 {
+			return nil, fmt.Errorf("invalid signature %q", sig)
+		}
+		decoded.Signature = append(decoded.Signature, b)
+	}
+	return decoded, nil
+}
@@ -0,0 +1,240 @@
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"google.golang.org/protobuf/proto"
+)
+
+// testRefBlock 產生指定高度的 ref block 快照，區塊 ID 前 8 bytes 為高度
+func testRefBlock(number int64, timestamp time.Time) types.TronRefBlock {
+	id := make([]byte, 32)
+	binary.BigEndian.PutUint64(id, uint64(number))
+	for i := 8; i < 32; i++ {
+		id[i] = byte(i)
+	}
+	return types.TronRefBlock{Number: number, Hash: hex.EncodeToString(id), Timestamp: timestamp.UnixMilli()}
+}
+
+func TestTronRefBlock(t *testing.T) {
+	ref := testRefBlock(0x1234abcd, time.Now())
+	refBytes, refHash, err := tronRefBlock(ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(refBytes) != "abcd" || hex.EncodeToString(refHash) != "08090a0b0c0d0e0f" {
+		t.Errorf("unexpected ref block bytes %x, hash %x", refBytes, refHash)
+	}
+
+	mismatched := ref
+	mismatched.Number++
+	if _, _, err := tronRefBlock(mismatched); err == nil {
+		t.Error("expected error for hash of another block")
+	}
+	invalid := ref
+	invalid.Hash = "abcd"
+	if _, _, err := tronRefBlock(invalid); err == nil {
+		t.Error("expected error for short hash")
+	}
+}
+
+func TestTronClient_OfflineBuildAndSign(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	owner := address.PubkeyToAddress(key.PublicKey)
+	c := &TronClient{}
+	ref := testRefBlock(100, time.Now())
+	req := types.TronOfflineBuildRequest{
+		RefBlock:          ref,
+		OwnerAddress:      owner.String(),
+		ToAddress:         "TNPeeaaFB7K9cmo4uQpcU32zGK8G1NYqeL",
+		Amount:            "1500000",
+		Memo:              "cold-wallet",
+		ExpirationSeconds: 12 * 3600,
+	}
+	tx, err := c.BuildOfflineTransaction(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := parseTronOfflineTransaction(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw := decoded.GetRawData()
+	if raw.GetExpiration() != ref.Timestamp+12*3600*1000 || string(raw.GetData()) != "cold-wallet" {
+		t.Errorf("unexpected raw data %v", raw)
+	}
+	if hex.EncodeToString(raw.GetRefBlockBytes()) != "0064" || tx.TxID != getTronTxID(decoded) || len(tx.Signature) != 0 {
+		t.Errorf("unexpected transaction %+v", tx)
+	}
+
+	// 以 JSON 搬運後簽名
+	portable, err := json.Marshal(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var carried types.TronOfflineTransaction
+	if err := json.Unmarshal(portable, &carried); err != nil {
+		t.Fatal(err)
+	}
+	signed, err := c.SignOfflineTransaction(hex.EncodeToString(crypto.FromECDSA(key)), carried)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signed.Signature) != 1 || signed.TxID != tx.TxID || signed.RawDataHex != tx.RawDataHex {
+		t.Errorf("unexpected signed transaction %+v", signed)
+	}
+	if _, err := c.SignOfflineTransaction(hex.EncodeToString(crypto.FromECDSA(key)), signed); err == nil {
+		t.Error("expected error when signing twice with the same key")
+	}
+
+	req.ExpirationSeconds = 25 * 3600
+	if _, err := c.BuildOfflineTransaction(req); err == nil {
+		t.Error("expected error for expiration beyond the protocol maximum")
+	}
+	req.ExpirationSeconds = 0
+	req.RefBlock = testRefBlock(100, time.Now().Add(-2*time.Hour))
+	expired, err := c.BuildOfflineTransaction(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.SignOfflineTransaction(hex.EncodeToString(crypto.FromECDSA(key)), expired); err == nil {
+		t.Error("expected error when signing an expired transaction")
+	}
+}
+
+func TestTronClient_OfflineTRC20(t *testing.T) {
+	c := &TronClient{}
+	tx, err := c.BuildOfflineTransaction(types.TronOfflineBuildRequest{
+		RefBlock:        testRefBlock(100, time.Now()),
+		OwnerAddress:    "TNPeeaaFB7K9cmo4uQpcU32zGK8G1NYqeL",
+		ToAddress:       "TNPeeaaFB7K9cmo4uQpcU32zGK8G1NYqeL",
+		Amount:          "1000000000000000000000",
+		ContractAddress: "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := parseTronOfflineTransaction(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract := decoded.GetRawData().GetContract()[0]
+	trigger := new(core.TriggerSmartContract)
+	if err := contract.GetParameter().UnmarshalTo(trigger); err != nil {
+		t.Fatal(err)
+	}
+	if contract.GetType() != core.Transaction_Contract_TriggerSmartContract || hex.EncodeToString(trigger.GetData()[:4]) != "a9059cbb" {
+		t.Errorf("expected TRC20 transfer, got %v", contract)
+	}
+	if decoded.GetRawData().GetFeeLimit() != DefaultTronFeeLimit {
+		t.Errorf("expected default fee limit, got %d", decoded.GetRawData().GetFeeLimit())
+	}
+}
+
+func TestParseTronOfflineTransaction_Tampered(t *testing.T) {
+	c := &TronClient{}
+	tx, err := c.BuildOfflineTransaction(types.TronOfflineBuildRequest{
+		RefBlock:     testRefBlock(100, time.Now()),
+		OwnerAddress: "TNPeeaaFB7K9cmo4uQpcU32zGK8G1NYqeL",
+		ToAddress:    "TNPeeaaFB7K9cmo4uQpcU32zGK8G1NYqeL",
+		Amount:       "1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wrongID := tx
+	wrongID.TxID = strings.Repeat("0", 64)
+	if _, err := parseTronOfflineTransaction(wrongID); err == nil {
+		t.Error("expected error for mismatched txID")
+	}
+	wrongView := tx
+	wrongView.RawData = json.RawMessage(strings.Replace(string(tx.RawData), `"amount":1`, `"amount":2`, 1))
+	if _, err := parseTronOfflineTransaction(wrongView); err == nil {
+		t.Error("expected error when raw_data differs from raw_data_hex")
+	}
+	hexOnly := types.TronOfflineTransaction{RawDataHex: tx.RawDataHex}
+	if _, err := parseTronOfflineTransaction(hexOnly); err != nil {
+		t.Errorf("expected raw_data_hex alone to be accepted, got %v", err)
+	}
+}
+
+func TestTronClient_OfflineRefBlockAndBroadcast(t *testing.T) {
+	ref := testRefBlock(100, time.Now())
+	var broadcast *core.Transaction
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		var resp interface{}
+		switch r.URL.Path {
+		case "/wallet/getnowblock":
+			resp = map[string]interface{}{
+				"blockID": ref.Hash,
+				"block_header": map[string]interface{}{"raw_data": map[string]interface{}{
+					"number": ref.Number, "timestamp": ref.Timestamp,
+				}},
+			}
+		case "/wallet/broadcasthex":
+			data, _ := hex.DecodeString(req["transaction"].(string))
+			broadcast = new(core.Transaction)
+			_ = proto.Unmarshal(data, broadcast)
+			resp = map[string]interface{}{"result": true}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	c := &TronClient{}
+	if err := c.Connect(context.Background(), srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	got, err := c.GetRefBlock(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != ref {
+		t.Fatalf("expected ref block %+v, got %+v", ref, got)
+	}
+
+	key, _ := crypto.GenerateKey()
+	tx, err := c.BuildOfflineTransaction(types.TronOfflineBuildRequest{
+		RefBlock:     got,
+		OwnerAddress: address.PubkeyToAddress(key.PublicKey).String(),
+		ToAddress:    "TNPeeaaFB7K9cmo4uQpcU32zGK8G1NYqeL",
+		Amount:       "1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.BroadcastOfflineTransaction(context.Background(), tx); err == nil {
+		t.Error("expected error for unsigned transaction")
+	}
+	signed, err := c.SignOfflineTransaction(hex.EncodeToString(crypto.FromECDSA(key)), tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txid, err := c.BroadcastOfflineTransaction(context.Background(), signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if txid != tx.TxID || broadcast == nil || len(broadcast.GetSignature()) != 1 {
+		t.Errorf("unexpected broadcast %s %v", txid, broadcast)
+	}
+}
@@ -0,0 +1,245 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"github.com/golang/protobuf/proto"
+)
+
+var _ types.TronResourceManager = (*TronClient)(nil)
+
+// 鏈參數缺少對應項目時使用的主網預設值（sun）
+const (
+	defaultTronEnergyFee        = 420
+	defaultTronTransactionFee   = 1000
+	defaultTronCreateAccountFee = 100_000
+	defaultTronActivationFee    = 1_000_000
+)
+
+// tronSignatureSize 每個簽名在交易中佔用的 bytes（65 bytes 簽名加上 protobuf tag 與長度）
+const tronSignatureSize = 67
+
+// tronMaxResultSize java-tron 計算頻寬時為交易結果預留的 bytes
+const tronMaxResultSize = 64
+
+// tronFeeParams 計算燃燒費用所需的鏈參數
+type tronFeeParams struct {
+	energyFee        int64 // 每單位能量 sun
+	transactionFee   int64 // 每 byte 頻寬 sun
+	createAccountFee int64 // 建立帳戶時頻寬不足燃燒的 sun
+	activationFee    int64 // 轉帳至新帳戶的啟用費
+}
+
+// GetAccountResources 實作 TronResourceManager 介面
+func (t *TronClient) GetAccountResources(ctx context.Context, addr string) (types.TronResourcesResponse, error) {
+	if t.client == nil {
+		return types.TronResourcesResponse{}, errors.New("Tron client not connected")
+	}
+	tronAddr, err := parseTronAddress(addr)
+	if err != nil {
+		return types.TronResourcesResponse{}, err
+	}
+	res, err := t.client.GetAccountResource(tronAddr.String())
+	if err != nil {
+		return types.TronResourcesResponse{}, err
+	}
+	return tronResources(tronAddr.String(), res), nil
+}
+
+// tronResources 轉換節點回傳的帳戶資源
+func tronResources(addr string, res *api.AccountResourceMessage) types.TronResourcesResponse {
+	return types.TronResourcesResponse{
+		Address:              addr,
+		FreeBandwidthLimit:   res.GetFreeNetLimit(),
+		FreeBandwidthUsed:    res.GetFreeNetUsed(),
+		StakedBandwidthLimit: res.GetNetLimit(),
+		StakedBandwidthUsed:  res.GetNetUsed(),
+		EnergyLimit:          res.GetEnergyLimit(),
+		EnergyUsed:           res.GetEnergyUsed(),
+		AvailableBandwidth:   remaining(res.GetFreeNetLimit(), res.GetFreeNetUsed()) + remaining(res.GetNetLimit(), res.GetNetUsed()),
+		AvailableEnergy:      remaining(res.GetEnergyLimit(), res.GetEnergyUsed()),
+	}
+}
+
+// EstimateFee 實作 TronResourceManager 介面
+// 由節點建立未簽名交易計算頻寬，合約呼叫以 EstimateEnergy 預估能量，
+// 節點未開啟 EstimateEnergy 時改用 TriggerConstantContract 的 energy_used
+func (t *TronClient) EstimateFee(ctx context.Context, req types.TronFeeEstimateRequest) (types.TronFeeEstimateResponse, error) {
+	if t.client == nil {
+		return types.TronFeeEstimateResponse{}, errors.New("Tron client not connected")
+	}
+	from, err := parseTronAddress(req.FromAddress)
+	if err != nil {
+		return types.TronFeeEstimateResponse{}, err
+	}
+
+	var (
+		tx         *core.Transaction
+		energy     int64
+		newAccount bool
+	)
+	if req.ContractAddress == "" {
+		to, err := parseTronAddress(req.ToAddress)
+		if err != nil {
+			return types.TronFeeEstimateResponse{}, err
+		}
+		amount, err := parseSunAmount(req.Amount)
+		if err != nil {
+			return types.TronFeeEstimateResponse{}, err
+		}
+		ext, err := t.client.Transfer(from.String(), to.String(), amount)
+		if err != nil {
+			return types.TronFeeEstimateResponse{}, err
+		}
+		if ext.GetTransaction().GetRawData() == nil {
+			return types.TronFeeEstimateResponse{}, errors.New("node returned no transaction")
+		}
+		tx = ext.GetTransaction()
+		if newAccount, err = t.isNewAccount(ctx, to); err != nil {
+			return types.TronFeeEstimateResponse{}, err
+		}
+	} else {
+		contract, err := parseTronAddress(req.ContractAddress)
+		if err != nil {
+			return types.TronFeeEstimateResponse{}, err
+		}
+		data, err := t.estimateCallData(req)
+		if err != nil {
+			return types.TronFeeEstimateResponse{}, err
+		}
+		ext, err := t.client.TRC20Call(from.String(), contract.String(), hex.EncodeToString(data), true, 0)
+		if err != nil {
+			return types.TronFeeEstimateResponse{}, err
+		}
+		if err := tronContractError(ext); err != nil {
+			return types.TronFeeEstimateResponse{}, err
+		}
+		if ext.GetTransaction().GetRawData() == nil {
+			return types.TronFeeEstimateResponse{}, errors.New("node returned no transaction")
+		}
+		tx = proto.Clone(ext.GetTransaction()).(*core.Transaction)
+		tx.RawData.FeeLimit = t.feeLimit()
+		energy = ext.GetEnergyUsed()
+		est, err := t.client.Client.EstimateEnergy(ctx, &core.TriggerSmartContract{
+			OwnerAddress:    from.Bytes(),
+			ContractAddress: contract.Bytes(),
+			Data:            data,
+		})
+		if err == nil && est.GetResult().GetResult() && est.GetEnergyRequired() > 0 {
+			energy = est.GetEnergyRequired()
+		}
+	}
+
+	res, err := t.client.GetAccountResource(from.String())
+	if err != nil {
+		return types.TronFeeEstimateResponse{}, err
+	}
+	params, err := t.feeParams(ctx)
+	if err != nil {
+		return types.TronFeeEstimateResponse{}, err
+	}
+	return estimateTronFee(res, params, tronTxBandwidth(tx, 1), energy, newAccount), nil
+}
+
+// estimateCallData 依請求打包 TRC20 transfer 或一般合約呼叫的 calldata
+func (t *TronClient) estimateCallData(req types.TronFeeEstimateRequest) ([]byte, error) {
+	if req.Method != "" {
+		_, data, err := packContractCall(req.ABI, req.Method, req.Params, parseTronABIAddress)
+		return data, err
+	}
+	to, err := parseTronAddress(req.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseSmallestUnit(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return erc20ABI.Pack("transfer", tronToEVMAddress(to), amount)
+}
+
+// isNewAccount 檢查地址是否尚未在鏈上啟用
+func (t *TronClient) isNewAccount(ctx context.Context, addr address.Address) (bool, error) {
+	acc, err := t.client.Client.GetAccount(ctx, &core.Account{Address: addr.Bytes()})
+	if err != nil {
+		return false, err
+	}
+	return len(acc.GetAddress()) == 0, nil
+}
+
+// feeParams 讀取鏈參數中的能量與頻寬單價
+func (t *TronClient) feeParams(ctx context.Context) (tronFeeParams, error) {
+	params := tronFeeParams{
+		energyFee:        defaultTronEnergyFee,
+		transactionFee:   defaultTronTransactionFee,
+		createAccountFee: defaultTronCreateAccountFee,
+		activationFee:    defaultTronActivationFee,
+	}
+	chain, err := t.client.Client.GetChainParameters(ctx, new(api.EmptyMessage))
+	if err != nil {
+		return params, err
+	}
+	for _, p := range chain.GetChainParameter() {
+		switch p.GetKey() {
+		case "getEnergyFee":
+			params.energyFee = p.GetValue()
+		case "getTransactionFee":
+			params.transactionFee = p.GetValue()
+		case "getCreateAccountFee":
+			params.createAccountFee = p.GetValue()
+		case "getCreateNewAccountFeeInSystemContract":
+			params.activationFee = p.GetValue()
+		}
+	}
+	return params, nil
+}
+
+// tronTxBandwidth 計算交易上鏈消耗的頻寬：不含結果的交易大小、簽名與預留的結果空間
+func tronTxBandwidth(tx *core.Transaction, signatures int) int64 {
+	unsigned := proto.Clone(tx).(*core.Transaction)
+	unsigned.Ret = nil
+	unsigned.Signature = nil
+	return int64(proto.Size(unsigned) + signatures*tronSignatureSize + tronMaxResultSize)
+}
+
+// estimateTronFee 依帳戶剩餘資源計算需燃燒的 TRX
+// 頻寬優先使用質押頻寬再使用免費頻寬，不足時整筆交易的頻寬改以 TRX 支付；
+// 轉帳至新帳戶只能使用質押頻寬，不足時燃燒建立帳戶費用，另需支付啟用費
+func estimateTronFee(res *api.AccountResourceMessage, params tronFeeParams, bandwidth, energy int64, newAccount bool) types.TronFeeEstimateResponse {
+	out := types.TronFeeEstimateResponse{
+		Bandwidth:  bandwidth,
+		Energy:     energy,
+		NewAccount: newAccount,
+	}
+	staked := remaining(res.GetNetLimit(), res.GetNetUsed())
+	free := remaining(res.GetFreeNetLimit(), res.GetFreeNetUsed())
+	switch {
+	case newAccount:
+		out.ActivationFeeSun = params.activationFee
+		if staked < bandwidth {
+			out.BandwidthBurnSun = params.createAccountFee
+		}
+	case staked < bandwidth && free < bandwidth:
+		out.BandwidthBurnSun = bandwidth * params.transactionFee
+	}
+	if missing := energy - remaining(res.GetEnergyLimit(), res.GetEnergyUsed()); missing > 0 {
+		out.EnergyBurnSun = missing * params.energyFee
+	}
+	out.TotalBurnSun = out.BandwidthBurnSun + out.EnergyBurnSun + out.ActivationFeeSun
+	out.TotalBurnTRX = float64(out.TotalBurnSun) / 1e6
+	return out
+}
+
+// remaining 回傳剩餘額度，不小於 0
+func remaining(limit, used int64) int64 {
+	if used >= limit {
+		return 0
+	}
+	return limit - used
+}
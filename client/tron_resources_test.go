@@ -0,0 +1,62 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+)
+
+func TestTronTxBandwidth(t *testing.T) {
+	tx := &core.Transaction{
+		RawData:   &core.TransactionRaw{RefBlockBytes: []byte{1, 2}, Expiration: 1, Timestamp: 1},
+		Signature: [][]byte{make([]byte, 65)},
+		Ret:       []*core.Transaction_Result{{ContractRet: core.Transaction_Result_SUCCESS}},
+	}
+	unsigned := &core.Transaction{RawData: tx.RawData}
+	// 已簽名交易的簽名與結果不重複計算
+	if got, want := tronTxBandwidth(tx, 1), tronTxBandwidth(unsigned, 1); got != want {
+		t.Errorf("expected %d, got %d", want, got)
+	}
+	if got := tronTxBandwidth(unsigned, 2) - tronTxBandwidth(unsigned, 1); got != tronSignatureSize {
+		t.Errorf("expected %d bytes per signature, got %d", tronSignatureSize, got)
+	}
+}
+
+func TestEstimateTronFee(t *testing.T) {
+	params := tronFeeParams{energyFee: 420, transactionFee: 1000, createAccountFee: 100_000, activationFee: 1_000_000}
+	tests := []struct {
+		name       string
+		res        *api.AccountResourceMessage
+		bandwidth  int64
+		energy     int64
+		newAccount bool
+		bandwidthF int64
+		energyF    int64
+		total      int64
+	}{
+		{"free bandwidth covers", &api.AccountResourceMessage{FreeNetLimit: 600, FreeNetUsed: 300}, 268, 0, false, 0, 0, 0},
+		{"burn whole bandwidth", &api.AccountResourceMessage{FreeNetLimit: 600, FreeNetUsed: 500, NetLimit: 100}, 345, 0, false, 345_000, 0, 345_000},
+		{"partial energy", &api.AccountResourceMessage{NetLimit: 1000, EnergyLimit: 10_000, EnergyUsed: 4_000}, 345, 14_650, false, 0, 8_650 * 420, 8_650 * 420},
+		{"new account without stake", &api.AccountResourceMessage{FreeNetLimit: 600}, 268, 0, true, 100_000, 0, 1_100_000},
+		{"new account with stake", &api.AccountResourceMessage{NetLimit: 1000}, 268, 0, true, 0, 0, 1_000_000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := estimateTronFee(tt.res, params, tt.bandwidth, tt.energy, tt.newAccount)
+			if got.BandwidthBurnSun != tt.bandwidthF || got.EnergyBurnSun != tt.energyF || got.TotalBurnSun != tt.total {
+				t.Errorf("unexpected estimate %+v", got)
+			}
+			if got.TotalBurnTRX != float64(tt.total)/1e6 {
+				t.Errorf("expected %v TRX, got %v", float64(tt.total)/1e6, got.TotalBurnTRX)
+			}
+		})
+	}
+}
+
+func TestTronResources(t *testing.T) {
+	res := tronResources("T", &api.AccountResourceMessage{FreeNetLimit: 600, FreeNetUsed: 700, NetLimit: 50, NetUsed: 10, EnergyLimit: 100, EnergyUsed: 30})
+	if res.AvailableBandwidth != 40 || res.AvailableEnergy != 70 {
+		t.Errorf("unexpected available resources %+v", res)
+	}
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"github.com/golang/protobuf/proto"
+)
+
+// TronSigner 波場交易本地簽名器
+// 對 raw_data 的 SHA-256 做 secp256k1 簽名，簽名附加至 Transaction.Signature
+type TronSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+// NewTronSigner 以十六進位私鑰建立簽名器
+func NewTronSigner(privateKey string) (*TronSigner, error) {
+	key, err := crypto.HexToECDSA(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &TronSigner{key: key}, nil
+}
+
+// Address 回傳簽名者地址
+func (s *TronSigner) Address() address.Address {
+	return address.PubkeyToAddress(s.key.PublicKey)
+}
+
+// Sign 簽署交易並回傳 txid，多簽時可由不同簽名器依序呼叫，簽名依序附加
+// 同一把私鑰重複簽名時回傳錯誤，避免節點以重複簽名拒絕交易
+func (s *TronSigner) Sign(tx *core.Transaction) (string, error) {
+	hash, err := tronTxHash(tx)
+	if err != nil {
+		return "", err
+	}
+	self := crypto.PubkeyToAddress(s.key.PublicKey)
+	for _, existing := range tx.GetSignature() {
+		pub, err := crypto.SigToPub(hash, existing)
+		if err == nil && crypto.PubkeyToAddress(*pub) == self {
+			return "", fmt.Errorf("transaction already signed by %s", s.Address().String())
+		}
+	}
+	sig, err := crypto.Sign(hash, s.key)
+	if err != nil {
+		return "", err
+	}
+	tx.Signature = append(tx.Signature, sig)
+	return hex.EncodeToString(hash), nil
+}
+
+// SignExtention 簽署節點建立的交易
+// 節點回傳的 txid 須與 raw_data 一致，避免簽到建立後被修改的交易
+func (s *TronSigner) SignExtention(ext *api.TransactionExtention) (string, error) {
+	if ext == nil || ext.GetTransaction() == nil {
+		return "", errors.New("node returned no transaction")
+	}
+	if len(ext.GetTxid()) > 0 {
+		if id := getTronTxID(ext.GetTransaction()); id != hex.EncodeToString(ext.GetTxid()) {
+			return "", fmt.Errorf("txid mismatch: node returned %x, transaction hashes to %s", ext.GetTxid(), id)
+		}
+	}
+	return s.Sign(ext.GetTransaction())
+}
+
+// tronTxHash 計算 raw_data 的 SHA-256，即交易 txid
+func tronTxHash(tx *core.Transaction) ([]byte, error) {
+	if tx == nil || tx.GetRawData() == nil {
+		return nil, errors.New("transaction has no raw_data")
+	}
+	raw, err := proto.Marshal(tx.GetRawData())
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(raw)
+	return hash[:], nil
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+)
+
+func testTronTransaction() *core.Transaction {
+	return &core.Transaction{RawData: &core.TransactionRaw{
+		RefBlockBytes: []byte{0x01, 0x02},
+		RefBlockHash:  []byte{0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a},
+		Expiration:    1700000060000,
+		Timestamp:     1700000000000,
+	}}
+}
+
+func TestTronSigner_Sign(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	signer, err := NewTronSigner(hex.EncodeToString(crypto.FromECDSA(key)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := testTronTransaction()
+	txid, err := signer.Sign(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if txid != getTronTxID(tx) {
+		t.Errorf("txid %s does not match getTronTxID %s", txid, getTronTxID(tx))
+	}
+	if len(tx.Signature) != 1 || len(tx.Signature[0]) != 65 {
+		t.Fatalf("expected one 65-byte signature, got %d", len(tx.Signature))
+	}
+	hash, _ := hex.DecodeString(txid)
+	pub, err := crypto.SigToPub(hash, tx.Signature[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address.PubkeyToAddress(*pub).String() != signer.Address().String() {
+		t.Error("signature does not recover to the signer address")
+	}
+}
+
+func TestTronSigner_SignExtentionTxidMismatch(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	signer, _ := NewTronSigner(hex.EncodeToString(crypto.FromECDSA(key)))
+	tx := testTronTransaction()
+	txid, _ := tronTxHash(tx)
+	ext := &api.TransactionExtention{Transaction: tx, Txid: txid}
+
+	// 節點建立後 raw_data 被修改但未更新 txid
+	tx.RawData.FeeLimit = 1
+	if _, err := signer.SignExtention(ext); err == nil {
+		t.Fatal("expected txid mismatch error")
+	}
+	if len(tx.Signature) != 0 {
+		t.Error("transaction must not be signed on mismatch")
+	}
+}
+
+func TestTronSigner_MultiSign(t *testing.T) {
+	tx := testTronTransaction()
+	var signers []*TronSigner
+	for i := 0; i < 2; i++ {
+		key, _ := crypto.GenerateKey()
+		signer, _ := NewTronSigner(hex.EncodeToString(crypto.FromECDSA(key)))
+		if _, err := signer.Sign(tx); err != nil {
+			t.Fatal(err)
+		}
+		signers = append(signers, signer)
+	}
+	if len(tx.Signature) != 2 {
+		t.Fatalf("expected 2 signatures, got %d", len(tx.Signature))
+	}
+	if _, err := signers[0].Sign(tx); err == nil {
+		t.Error("expected error when the same key signs twice")
+	}
+	if len(tx.Signature) != 2 {
+		t.Errorf("duplicate signature must not be appended, got %d", len(tx.Signature))
+	}
+}
@@ -0,0 +1,234 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+)
+
+var _ types.TronStakeManager = (*TronClient)(nil)
+
+// FreezeBalanceV2 實作 TronStakeManager 介面
+func (t *TronClient) FreezeBalanceV2(ctx context.Context, privateKey, resource string, amount int64) (string, error) {
+	code, err := parseTronResource(resource)
+	if err != nil {
+		return "", err
+	}
+	if amount <= 0 {
+		return "", errors.New("amount must be positive")
+	}
+	return t.stakeTx(privateKey, func(owner string) (*api.TransactionExtention, error) {
+		return t.client.FreezeBalanceV2(owner, code, amount)
+	})
+}
+
+// UnfreezeBalanceV2 實作 TronStakeManager 介面
+func (t *TronClient) UnfreezeBalanceV2(ctx context.Context, privateKey, resource string, amount int64) (string, error) {
+	code, err := parseTronResource(resource)
+	if err != nil {
+		return "", err
+	}
+	if amount <= 0 {
+		return "", errors.New("amount must be positive")
+	}
+	return t.stakeTx(privateKey, func(owner string) (*api.TransactionExtention, error) {
+		return t.client.UnfreezeBalanceV2(owner, code, amount)
+	})
+}
+
+// WithdrawExpireUnfreeze 實作 TronStakeManager 介面
+func (t *TronClient) WithdrawExpireUnfreeze(ctx context.Context, privateKey string) (string, error) {
+	return t.stakeTx(privateKey, func(owner string) (*api.TransactionExtention, error) {
+		return t.client.WithdrawExpireUnfreeze(owner, time.Now().UnixMilli())
+	})
+}
+
+// DelegateResource 實作 TronStakeManager 介面
+func (t *TronClient) DelegateResource(ctx context.Context, privateKey, receiver, resource string, amount, lockPeriod int64) (string, error) {
+	code, err := parseTronResource(resource)
+	if err != nil {
+		return "", err
+	}
+	to, err := parseTronAddress(receiver)
+	if err != nil {
+		return "", err
+	}
+	if amount <= 0 {
+		return "", errors.New("amount must be positive")
+	}
+	if lockPeriod < 0 {
+		return "", errors.New("lock period must not be negative")
+	}
+	return t.stakeTx(privateKey, func(owner string) (*api.TransactionExtention, error) {
+		return t.client.DelegateResource(owner, to.String(), code, amount, lockPeriod > 0, lockPeriod)
+	})
+}
+
+// UnDelegateResource 實作 TronStakeManager 介面
+func (t *TronClient) UnDelegateResource(ctx context.Context, privateKey, receiver, resource string, amount int64) (string, error) {
+	code, err := parseTronResource(resource)
+	if err != nil {
+		return "", err
+	}
+	to, err := parseTronAddress(receiver)
+	if err != nil {
+		return "", err
+	}
+	if amount <= 0 {
+		return "", errors.New("amount must be positive")
+	}
+	return t.stakeTx(privateKey, func(owner string) (*api.TransactionExtention, error) {
+		return t.client.UnDelegateResource(owner, to.String(), code, amount)
+	})
+}
+
+// GetStakeInfo 實作 TronStakeManager 介面
+func (t *TronClient) GetStakeInfo(ctx context.Context, addr string) (types.TronStakeResponse, error) {
+	if t.client == nil {
+		return types.TronStakeResponse{}, errors.New("Tron client not connected")
+	}
+	tronAddr, err := parseTronAddress(addr)
+	if err != nil {
+		return types.TronStakeResponse{}, err
+	}
+	owner := tronAddr.String()
+	acc, err := t.client.GetAccount(owner)
+	if err != nil {
+		return types.TronStakeResponse{}, err
+	}
+	info := tronStakeInfo(owner, acc)
+
+	for _, r := range []struct {
+		code core.ResourceCode
+		out  *int64
+	}{
+		{core.ResourceCode_BANDWIDTH, &info.DelegatableBandwidth},
+		{core.ResourceCode_ENERGY, &info.DelegatableEnergy},
+	} {
+		size, err := t.client.GetCanDelegatedMaxSize(owner, int32(r.code))
+		if err != nil {
+			return types.TronStakeResponse{}, err
+		}
+		*r.out = size.GetMaxSize()
+	}
+	withdrawable, err := t.client.GetCanWithdrawUnfreezeAmount(owner, time.Now().UnixMilli())
+	if err != nil {
+		return types.TronStakeResponse{}, err
+	}
+	info.WithdrawableAmount = withdrawable.GetAmount()
+	count, err := t.client.GetAvailableUnfreezeCount(owner)
+	if err != nil {
+		return types.TronStakeResponse{}, err
+	}
+	info.AvailableUnfreezeCount = count.GetCount()
+	return info, nil
+}
+
+// GetDelegatedResources 實作 TronStakeManager 介面
+func (t *TronClient) GetDelegatedResources(ctx context.Context, addr string) ([]types.TronDelegationResponse, error) {
+	if t.client == nil {
+		return nil, errors.New("Tron client not connected")
+	}
+	tronAddr, err := parseTronAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	lists, err := t.client.GetDelegatedResourcesV2(tronAddr.String())
+	if err != nil {
+		return nil, err
+	}
+	out := []types.TronDelegationResponse{}
+	for _, list := range lists {
+		for _, d := range list.GetDelegatedResource() {
+			out = append(out, tronDelegation(d))
+		}
+	}
+	return out, nil
+}
+
+// stakeTx 以私鑰地址建立質押相關交易，簽名後廣播
+func (t *TronClient) stakeTx(privateKey string, build func(owner string) (*api.TransactionExtention, error)) (string, error) {
+	if t.client == nil {
+		return "", errors.New("Tron client not connected")
+	}
+	signer, err := NewTronSigner(privateKey)
+	if err != nil {
+		return "", err
+	}
+	ext, err := build(signer.Address().String())
+	if err != nil {
+		return "", err
+	}
+	if code := ext.GetResult().GetCode(); code != api.Return_SUCCESS {
+		return "", fmt.Errorf("%s: %s", code, ext.GetResult().GetMessage())
+	}
+	return t.signAndBroadcast(signer, ext)
+}
+
+// tronStakeInfo 由帳戶資料整理質押、代理與解鎖中的金額
+func tronStakeInfo(addr string, acc *core.Account) types.TronStakeResponse {
+	info := types.TronStakeResponse{
+		Address:            addr,
+		DelegatedBandwidth: acc.GetDelegatedFrozenV2BalanceForBandwidth(),
+		AcquiredBandwidth:  acc.GetAcquiredDelegatedFrozenV2BalanceForBandwidth(),
+		DelegatedEnergy:    acc.GetAccountResource().GetDelegatedFrozenV2BalanceForEnergy(),
+		AcquiredEnergy:     acc.GetAccountResource().GetAcquiredDelegatedFrozenV2BalanceForEnergy(),
+		Unfreezing:         []types.TronUnfreezeResponse{},
+	}
+	for _, f := range acc.GetFrozenV2() {
+		switch f.GetType() {
+		case core.ResourceCode_BANDWIDTH:
+			info.FrozenBandwidth += f.GetAmount()
+		case core.ResourceCode_ENERGY:
+			info.FrozenEnergy += f.GetAmount()
+		}
+	}
+	for _, u := range acc.GetUnfrozenV2() {
+		info.Unfreezing = append(info.Unfreezing, types.TronUnfreezeResponse{
+			Resource:   tronResourceName(u.GetType()),
+			Amount:     u.GetUnfreezeAmount(),
+			ExpireTime: u.GetUnfreezeExpireTime(),
+		})
+	}
+	return info
+}
+
+// tronDelegation 轉換單筆資源代理紀錄
+func tronDelegation(d *core.DelegatedResource) types.TronDelegationResponse {
+	return types.TronDelegationResponse{
+		ReceiverAddress:     address.Address(d.GetTo()).String(),
+		BandwidthAmount:     d.GetFrozenBalanceForBandwidth(),
+		EnergyAmount:        d.GetFrozenBalanceForEnergy(),
+		BandwidthExpireTime: d.GetExpireTimeForBandwidth(),
+		EnergyExpireTime:    d.GetExpireTimeForEnergy(),
+	}
+}
+
+// parseTronResource 解析資源類型，僅支援 bandwidth 與 energy
+func parseTronResource(resource string) (core.ResourceCode, error) {
+	switch strings.ToLower(strings.TrimSpace(resource)) {
+	case "bandwidth":
+		return core.ResourceCode_BANDWIDTH, nil
+	case "energy":
+		return core.ResourceCode_ENERGY, nil
+	}
+	return 0, fmt.Errorf("unsupported resource %q, expected bandwidth or energy", resource)
+}
+
+// tronResourceName 回傳資源類型的 API 名稱
+func tronResourceName(code core.ResourceCode) string {
+	switch code {
+	case core.ResourceCode_BANDWIDTH:
+		return "bandwidth"
+	case core.ResourceCode_ENERGY:
+		return "energy"
+	}
+	return strings.ToLower(code.String())
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+)
+
+func TestParseTronResource(t *testing.T) {
+	for in, want := range map[string]core.ResourceCode{
+		"bandwidth": core.ResourceCode_BANDWIDTH,
+		" Energy ":  core.ResourceCode_ENERGY,
+	} {
+		got, err := parseTronResource(in)
+		if err != nil || got != want {
+			t.Errorf("parseTronResource(%q) = %v, %v", in, got, err)
+		}
+	}
+	if _, err := parseTronResource("tron_power"); err == nil {
+		t.Error("expected error for unsupported resource")
+	}
+}
+
+func TestTronStakeInfo(t *testing.T) {
+	acc := &core.Account{
+		FrozenV2: []*core.Account_FreezeV2{
+			{Type: core.ResourceCode_BANDWIDTH, Amount: 10},
+			{Type: core.ResourceCode_ENERGY, Amount: 200},
+			{Type: core.ResourceCode_TRON_POWER, Amount: 5},
+		},
+		UnfrozenV2: []*core.Account_UnFreezeV2{
+			{Type: core.ResourceCode_ENERGY, UnfreezeAmount: 30, UnfreezeExpireTime: 1700000000000},
+		},
+		DelegatedFrozenV2BalanceForBandwidth: 4,
+		AccountResource: &core.Account_AccountResource{
+			DelegatedFrozenV2BalanceForEnergy:         50,
+			AcquiredDelegatedFrozenV2BalanceForEnergy: 7,
+		},
+	}
+	info := tronStakeInfo("T", acc)
+	if info.FrozenBandwidth != 10 || info.FrozenEnergy != 200 {
+		t.Errorf("unexpected frozen amounts %+v", info)
+	}
+	if info.DelegatedBandwidth != 4 || info.DelegatedEnergy != 50 || info.AcquiredEnergy != 7 {
+		t.Errorf("unexpected delegated amounts %+v", info)
+	}
+	if len(info.Unfreezing) != 1 || info.Unfreezing[0].Resource != "energy" || info.Unfreezing[0].Amount != 30 {
+		t.Errorf("unexpected unfreezing %+v", info.Unfreezing)
+	}
+}
+
+func TestTronDelegation(t *testing.T) {
+	to := address.HexToAddress("41a614f803b6fd780986a42c78ec9c7f77e6ded13c")
+	d := tronDelegation(&core.DelegatedResource{To: to.Bytes(), FrozenBalanceForEnergy: 100, ExpireTimeForEnergy: 5})
+	if d.ReceiverAddress != to.String() || d.EnergyAmount != 100 || d.EnergyExpireTime != 5 || d.BandwidthAmount != 0 {
+		t.Errorf("unexpected delegation %+v", d)
+	}
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+)
+
+const (
+	// tronBlockInterval 波場出塊間隔，作為新區塊輪詢週期
+	tronBlockInterval = 3 * time.Second
+	// tronPendingInterval 待打包交易輪詢週期
+	tronPendingInterval = time.Second
+	// tronMaxCatchUp 單次輪詢最多補發的區塊數，落後更多時只補最近的區塊
+	tronMaxCatchUp = 20
+)
+
+var _ types.BlockSubscriber = (*TronClient)(nil)
+
+// SubscribeNewBlocks 实现 BlockSubscriber
+// 波場節點不支援推播，以出塊間隔輪詢最新區塊並補發中間漏掉的區塊
+func (t *TronClient) SubscribeNewBlocks(ctx context.Context) (<-chan types.BlockHeaderResponse, error) {
+	if t.client == nil {
+		return nil, errors.New("Tron client not connected")
+	}
+	latest, err := t.client.GetNowBlock()
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan types.BlockHeaderResponse, streamBufferSize)
+	go func() {
+		defer close(out)
+		last := latest.GetBlockHeader().GetRawData().GetNumber()
+		trySend(out, tronBlockHeader(latest))
+		ticker := time.NewTicker(tronBlockInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			now, err := t.client.GetNowBlock()
+			if err != nil {
+				continue
+			}
+			head := now.GetBlockHeader().GetRawData().GetNumber()
+			if head <= last {
+				continue
+			}
+			start := last + 1
+			if head-start >= tronMaxCatchUp {
+				start = head - tronMaxCatchUp + 1
+			}
+			for num := start; num < head; num++ {
+				b, err := t.client.GetBlockByNum(num)
+				if err != nil {
+					break
+				}
+				trySend(out, tronBlockHeader(b))
+			}
+			trySend(out, tronBlockHeader(now))
+			last = head
+		}
+	}()
+	return out, nil
+}
+
+// SubscribePendingTransactions 实现 BlockSubscriber
+// 輪詢節點的待打包交易池，只送出新出現的交易 ID；節點未開放交易池查詢時回傳錯誤
+func (t *TronClient) SubscribePendingTransactions(ctx context.Context) (<-chan string, error) {
+	if t.client == nil {
+		return nil, errors.New("Tron client not connected")
+	}
+	seen, err := t.pendingTransactionIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan string, streamBufferSize)
+	go func() {
+		defer close(out)
+		ticker := time.NewTicker(tronPendingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			ids, err := t.pendingTransactionIDs(ctx)
+			if err != nil {
+				continue
+			}
+			for id := range ids {
+				if _, ok := seen[id]; !ok {
+					trySend(out, id)
+				}
+			}
+			// 只保留最近一次的交易池內容，已打包的交易自然淘汰
+			seen = ids
+		}
+	}()
+	return out, nil
+}
+
+// pendingTransactionIDs 查詢節點交易池中的交易 ID
+func (t *TronClient) pendingTransactionIDs(ctx context.Context) (map[string]struct{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, tronPendingInterval*5)
+	defer cancel()
+	list, err := t.client.Client.GetTransactionListFromPending(ctx, new(api.EmptyMessage))
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]struct{}, len(list.GetTxId()))
+	for _, id := range list.GetTxId() {
+		ids[id] = struct{}{}
+	}
+	return ids, nil
+}
+
+// tronBlockHeader 將波場區塊轉為新區塊事件
+func tronBlockHeader(b *api.BlockExtention) types.BlockHeaderResponse {
+	raw := b.GetBlockHeader().GetRawData()
+	return types.BlockHeaderResponse{
+		Number:     uint64(raw.GetNumber()),
+		Hash:       hex.EncodeToString(b.GetBlockid()),
+		ParentHash: hex.EncodeToString(raw.GetParentHash()),
+		Timestamp:  raw.GetTimestamp() / 1000,
+	}
+}
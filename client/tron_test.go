@@ -3,15 +3,20 @@ package client
 import (
 	"context"
 	"math/big"
+	"strings"
 	"testing"
+
+	"github.com/blockchain-sdk-go/api/types"
 )
 
 func TestTronClient_Connect(t *testing.T) {
+	addr, _ := testGRPCServer(t)
 	client := &TronClient{}
-	err := client.Connect(context.Background(), "grpc.trongrid.io:50051")
+	err := client.Connect(context.Background(), addr)
 	if err != nil {
 		t.Errorf("Connect failed: %v", err)
 	}
+	client.Close()
 }
 
 func TestTronClient_GenerateNewWallet(t *testing.T) {
@@ -26,9 +31,10 @@ func TestTronClient_GenerateNewWallet(t *testing.T) {
 }
 
 func TestTronClient_GetNativeBalance(t *testing.T) {
+	addr, _ := testGRPCServer(t)
 	client := &TronClient{}
 	// 需先 Connect
-	_ = client.Connect(context.Background(), "grpc.trongrid.io:50051")
+	_ = client.Connect(context.Background(), addr)
 	_, err := client.GetNativeBalance(context.Background(), "TXYz7Qw2Qw2Qw2Qw2Qw2Qw2Qw2Qw2Qw2Qw")
 	if err == nil {
 		t.Error("expected error for invalid address, got nil")
@@ -36,14 +42,28 @@ func TestTronClient_GetNativeBalance(t *testing.T) {
 }
 
 func TestTronClient_SendNativeToken(t *testing.T) {
+	addr, _ := testGRPCServer(t)
 	client := &TronClient{}
-	_ = client.Connect(context.Background(), "grpc.trongrid.io:50051")
+	_ = client.Connect(context.Background(), addr)
 	_, err := client.SendNativeToken(context.Background(), "invalidprivkey", "TXYz7Qw2Qw2Qw2Qw2Qw2Qw2Qw2Qw2Qw2Qw", big.NewFloat(1))
 	if err == nil {
 		t.Error("expected error for invalid private key, got nil")
 	}
 }
 
+func TestTronClient_DeployContractResourcePercent(t *testing.T) {
+	addr, _ := testGRPCServer(t)
+	client := &TronClient{}
+	_ = client.Connect(context.Background(), addr)
+	defer client.Close()
+	for _, percent := range []int64{-1, 101} {
+		_, _, err := client.DeployContract(context.Background(), "01", "00", "[]", nil, types.DeployOptions{ConsumeUserResourcePercent: &percent})
+		if err == nil || !strings.Contains(err.Error(), "consume_user_resource_percent") {
+			t.Errorf("percent %d: expected range error, got %v", percent, err)
+		}
+	}
+}
+
 func TestTronClient_Close(t *testing.T) {
 	client := &TronClient{}
 	if err := client.Close(); err != nil {
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+)
+
+// TronTransport 波場節點傳輸層
+// 以 Wallet 服務的 gRPC 方法名稱呼叫節點，gRPC 與 HTTP/JSON 實作皆可交給 api.NewWalletClient 使用，
+// TronClient 的所有方法因此不需區分連線方式
+type TronTransport interface {
+	grpc.ClientConnInterface
+	Close() error
+}
+
+var (
+	_ TronTransport = (*grpc.ClientConn)(nil)
+	_ TronTransport = (*tronHTTPTransport)(nil)
+)
+
+// dialTronTransport 依 URL scheme 選擇傳輸層
+// http:// 與 https:// 使用 HTTP /wallet API（如 https://api.trongrid.io），
+// grpcs:// 使用 TLS gRPC，grpc:// 或不帶 scheme 的 host:port 依 TLS.Enabled 決定
+func dialTronTransport(ctx context.Context, nodeURL string, cfg TronConfig) (TronTransport, error) {
+	if nodeURL == "" {
+		return nil, errors.New("Tron node URL is empty")
+	}
+	timeout := cfg.DialTimeout
+	if timeout <= 0 {
+		timeout = DefaultTronDialTimeout
+	}
+	if strings.HasPrefix(nodeURL, "http://") || strings.HasPrefix(nodeURL, "https://") {
+		if _, err := url.ParseRequestURI(nodeURL); err != nil {
+			return nil, err
+		}
+		tlsConfig, err := tronTLSConfig(cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
+		return newTronHTTPTransport(nodeURL, cfg.headers(), tlsConfig, timeout), nil
+	}
+
+	useTLS := cfg.TLS.Enabled
+	target := strings.TrimPrefix(nodeURL, "grpc://")
+	if strings.HasPrefix(nodeURL, "grpcs://") {
+		useTLS = true
+		target = strings.TrimPrefix(nodeURL, "grpcs://")
+	}
+	creds := insecure.NewCredentials()
+	if useTLS {
+		tlsConfig, err := tronTLSConfig(cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	keepaliveTime, keepaliveTimeout := cfg.KeepaliveTime, cfg.KeepaliveTimeout
+	if keepaliveTime <= 0 {
+		keepaliveTime = DefaultTronKeepaliveTime
+	}
+	if keepaliveTimeout <= 0 {
+		keepaliveTimeout = DefaultTronKeepaliveTimeout
+	}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{Time: keepaliveTime, Timeout: keepaliveTimeout}),
+	}
+	if headers := cfg.headers(); len(headers) > 0 {
+		opts = append(opts, grpc.WithUnaryInterceptor(tronMetadataInterceptor(headers)))
+	}
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := waitForReady(dialCtx, conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("connect %s: %w", target, err)
+	}
+	return conn, nil
+}
+
+// waitForReady 主動建立連線並等待就緒，逾時或 ctx 取消時回傳錯誤
+func waitForReady(ctx context.Context, conn *grpc.ClientConn) error {
+	conn.Connect()
+	for {
+		state := conn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("%w (last state %s)", ctx.Err(), state)
+		}
+	}
+}
+
+// tronMetadataInterceptor 每次呼叫時附帶 API key 等 metadata
+func tronMetadataInterceptor(headers map[string]string) grpc.UnaryClientInterceptor {
+	pairs := make([]string, 0, len(headers)*2)
+	for k, v := range headers {
+		pairs = append(pairs, strings.ToLower(k), v)
+	}
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(metadata.AppendToOutgoingContext(ctx, pairs...), method, req, reply, cc, opts...)
+	}
+}
+
+// tronTLSConfig 建立 TLS 設定，未提供 CA 時使用系統 CA
+func tronTLSConfig(c types.TLSConfig) (*tls.Config, error) {
+	conf := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: c.ServerName}
+	if c.CACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(c.CACert)) {
+			return nil, errors.New("invalid TLS CA certificate")
+		}
+		conf.RootCAs = pool
+	}
+	if c.ClientCert != "" || c.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(c.ClientCert), []byte(c.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid TLS client certificate: %w", err)
+		}
+		conf.Certificates = []tls.Certificate{cert}
+	}
+	return conf, nil
+}
+
+// tronHTTPClient 建立 HTTP client，連線逾時與 TLS 設定與 gRPC 一致
+func tronHTTPClient(tlsConfig *tls.Config, dialTimeout time.Duration) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{Timeout: dialTimeout, KeepAlive: DefaultTronKeepaliveTime}).DialContext
+	transport.TLSClientConfig = tlsConfig
+	transport.TLSHandshakeTimeout = dialTimeout
+	return &http.Client{Transport: transport}
+}
@@ -0,0 +1,218 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// testTLSCert 產生 localhost 自簽憑證，回傳憑證與 PEM 格式的憑證、私鑰
+func testTLSCert(t *testing.T) (tls.Certificate, string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	cert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, certPEM, keyPEM
+}
+
+// testGRPCServer 啟動本機 gRPC 節點，所有呼叫回傳 Unimplemented 並記錄收到的 metadata
+func testGRPCServer(t *testing.T, opts ...grpc.ServerOption) (string, <-chan metadata.MD) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	received := make(chan metadata.MD, 1)
+	opts = append(opts, grpc.UnknownServiceHandler(func(srv interface{}, stream grpc.ServerStream) error {
+		md, _ := metadata.FromIncomingContext(stream.Context())
+		select {
+		case received <- md:
+		default:
+		}
+		return status.Error(codes.Unimplemented, "test server")
+	}))
+	srv := grpc.NewServer(opts...)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String(), received
+}
+
+func TestDialTronTransport_GRPC(t *testing.T) {
+	addr, received := testGRPCServer(t)
+	for _, url := range []string{addr, "grpc://" + addr} {
+		tr, err := dialTronTransport(context.Background(), url, TronConfig{})
+		if err != nil {
+			t.Fatalf("%s: %v", url, err)
+		}
+		if _, ok := tr.(*grpc.ClientConn); !ok {
+			t.Errorf("%s: expected gRPC transport, got %T", url, tr)
+		}
+		tr.Close()
+	}
+
+	cfg := TronConfig{APIKey: "test-key", Headers: map[string]string{"X-Client": "sdk"}}
+	tr, err := dialTronTransport(context.Background(), addr, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tr.Close()
+	_, _ = api.NewWalletClient(tr).GetNowBlock2(context.Background(), new(api.EmptyMessage))
+	md := <-received
+	if got := md.Get("tron-pro-api-key"); len(got) != 1 || got[0] != "test-key" {
+		t.Errorf("expected api key metadata, got %v", md)
+	}
+	if got := md.Get("x-client"); len(got) != 1 || got[0] != "sdk" {
+		t.Errorf("expected custom metadata, got %v", md)
+	}
+}
+
+func TestDialTronTransport_GRPCTLS(t *testing.T) {
+	cert, certPEM, keyPEM := testTLSCert(t)
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM([]byte(certPEM))
+	serverTLS := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	addr, _ := testGRPCServer(t, grpc.Creds(credentials.NewTLS(serverTLS)))
+
+	cfg := TronConfig{
+		TLS:         types.TLSConfig{CACert: certPEM, ClientCert: certPEM, ClientKey: keyPEM, ServerName: "localhost"},
+		DialTimeout: 5 * time.Second,
+	}
+	tr, err := dialTronTransport(context.Background(), "grpcs://"+addr, cfg)
+	if err != nil {
+		t.Fatalf("expected TLS connection, got %v", err)
+	}
+	tr.Close()
+
+	cfg.TLS.ClientCert, cfg.TLS.ClientKey = "", ""
+	cfg.DialTimeout = 500 * time.Millisecond
+	if _, err := dialTronTransport(context.Background(), "grpcs://"+addr, cfg); err == nil {
+		t.Error("expected error without client certificate")
+	}
+}
+
+func TestDialTronTransport_Timeout(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := lis.Addr().String()
+	lis.Close()
+
+	start := time.Now()
+	_, err = dialTronTransport(context.Background(), addr, TronConfig{DialTimeout: 300 * time.Millisecond})
+	if err == nil {
+		t.Fatal("expected dial error for closed port")
+	}
+	if time.Since(start) > 3*time.Second {
+		t.Errorf("dial did not honor timeout, took %s", time.Since(start))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := dialTronTransport(ctx, addr, TronConfig{}); err == nil || !strings.Contains(err.Error(), "canceled") {
+		t.Errorf("expected canceled context error, got %v", err)
+	}
+}
+
+func TestDialTronTransport_HTTP(t *testing.T) {
+	tr, err := dialTronTransport(context.Background(), "https://api.trongrid.io/wallet", TronConfig{APIKey: "k"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, ok := tr.(*tronHTTPTransport)
+	if !ok || h.baseURL != "https://api.trongrid.io" || h.headers["TRON-PRO-API-KEY"] != "k" {
+		t.Errorf("unexpected HTTP transport %+v", tr)
+	}
+	if _, err := dialTronTransport(context.Background(), "", TronConfig{}); err == nil {
+		t.Error("expected error for empty URL")
+	}
+	if _, err := dialTronTransport(context.Background(), "https://node", TronConfig{TLS: types.TLSConfig{CACert: "bad"}}); err == nil {
+		t.Error("expected error for invalid CA certificate")
+	}
+}
+
+func TestTronClient_HTTPSCustomCA(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"address": req["address"], "balance": 7})
+	}))
+	defer srv.Close()
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	c := &TronClient{}
+	if err := c.Connect(context.Background(), srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetNativeBalance(context.Background(), "TNPeeaaFB7K9cmo4uQpcU32zGK8G1NYqeL"); err == nil {
+		t.Error("expected certificate error without custom CA")
+	}
+
+	err := c.ConnectWithOptions(context.Background(), types.ConnectRequest{URL: srv.URL, TLS: &types.TLSConfig{CACert: caPEM}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	balance, err := c.GetNativeBalance(context.Background(), "TNPeeaaFB7K9cmo4uQpcU32zGK8G1NYqeL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Cmp(big.NewFloat(7)) != 0 {
+		t.Errorf("expected balance 7, got %s", balance.String())
+	}
+	if c.config.TLS.CACert != caPEM {
+		t.Error("expected connect options to be kept in client config")
+	}
+}
@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+)
+
+var _ types.TRC10Manager = (*TronClient)(nil)
+
+// GetAssetBalances 實作 TRC10Manager 介面，依帳戶 assetV2 列出持有的資產，依資產 ID 排序
+func (t *TronClient) GetAssetBalances(ctx context.Context, addr string) ([]types.TRC10BalanceResponse, error) {
+	acc, err := t.tronAccount(addr)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(acc.GetAssetV2()))
+	for id := range acc.GetAssetV2() {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	out := make([]types.TRC10BalanceResponse, 0, len(ids))
+	for _, id := range ids {
+		asset, err := t.trc10Asset(id)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, trc10Balance(asset, acc.GetAssetV2()[id]))
+	}
+	return out, nil
+}
+
+// GetAssetBalance 實作 TRC10Manager 介面
+func (t *TronClient) GetAssetBalance(ctx context.Context, addr, assetID string) (types.TRC10BalanceResponse, error) {
+	if err := validateTRC10ID(assetID); err != nil {
+		return types.TRC10BalanceResponse{}, err
+	}
+	acc, err := t.tronAccount(addr)
+	if err != nil {
+		return types.TRC10BalanceResponse{}, err
+	}
+	asset, err := t.trc10Asset(assetID)
+	if err != nil {
+		return types.TRC10BalanceResponse{}, err
+	}
+	return trc10Balance(asset, acc.GetAssetV2()[assetID]), nil
+}
+
+// TransferAsset 實作 TRC10Manager 介面，依資產 precision 將金額換算為最小單位
+func (t *TronClient) TransferAsset(ctx context.Context, fromPrivateKey, assetID, toAddress string, amount *big.Float) (string, error) {
+	if t.client == nil {
+		return "", errors.New("Tron client not connected")
+	}
+	if err := validateTRC10ID(assetID); err != nil {
+		return "", err
+	}
+	to, err := parseTronAddress(toAddress)
+	if err != nil {
+		return "", err
+	}
+	signer, err := NewTronSigner(fromPrivateKey)
+	if err != nil {
+		return "", err
+	}
+	asset, err := t.trc10Asset(assetID)
+	if err != nil {
+		return "", err
+	}
+	value, err := toSmallestUnit(amount, uint8(asset.GetPrecision()))
+	if err != nil {
+		return "", err
+	}
+	if !value.IsInt64() {
+		return "", fmt.Errorf("amount %s overflows int64", value)
+	}
+	ext, err := t.client.TransferAsset(signer.Address().String(), to.String(), assetID, value.Int64())
+	if err != nil {
+		return "", err
+	}
+	return t.signAndBroadcast(signer, ext)
+}
+
+// GetAssetInfo 實作 TRC10Manager 介面
+func (t *TronClient) GetAssetInfo(ctx context.Context, assetID string) (types.TRC10AssetResponse, error) {
+	if err := validateTRC10ID(assetID); err != nil {
+		return types.TRC10AssetResponse{}, err
+	}
+	asset, err := t.trc10Asset(assetID)
+	if err != nil {
+		return types.TRC10AssetResponse{}, err
+	}
+	return types.TRC10AssetResponse{
+		AssetID:      asset.GetId(),
+		Name:         string(asset.GetName()),
+		Abbr:         string(asset.GetAbbr()),
+		Precision:    asset.GetPrecision(),
+		TotalSupply:  strconv.FormatInt(asset.GetTotalSupply(), 10),
+		OwnerAddress: address.Address(asset.GetOwnerAddress()).String(),
+		Description:  string(asset.GetDescription()),
+		URL:          string(asset.GetUrl()),
+	}, nil
+}
+
+// tronAccount 查詢帳戶資料
+func (t *TronClient) tronAccount(addr string) (*core.Account, error) {
+	if t.client == nil {
+		return nil, errors.New("Tron client not connected")
+	}
+	tronAddr, err := parseTronAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	return t.client.GetAccount(tronAddr.String())
+}
+
+// trc10Asset 依 ID 查詢資產，節點找不到時回傳空資料，視為錯誤
+func (t *TronClient) trc10Asset(assetID string) (*core.AssetIssueContract, error) {
+	if t.client == nil {
+		return nil, errors.New("Tron client not connected")
+	}
+	asset, err := t.client.GetAssetIssueByID(assetID)
+	if err != nil {
+		return nil, err
+	}
+	if asset.GetId() == "" {
+		return nil, fmt.Errorf("TRC10 asset %s not found", assetID)
+	}
+	return asset, nil
+}
+
+// trc10Balance 依資產 precision 換算餘額
+func trc10Balance(asset *core.AssetIssueContract, raw int64) types.TRC10BalanceResponse {
+	value := big.NewInt(raw)
+	balance, _ := fromSmallestUnit(value, uint8(asset.GetPrecision())).Float64()
+	return types.TRC10BalanceResponse{
+		AssetID:    asset.GetId(),
+		Name:       string(asset.GetName()),
+		Abbr:       string(asset.GetAbbr()),
+		Precision:  asset.GetPrecision(),
+		Balance:    balance,
+		RawBalance: value.String(),
+	}
+}
+
+// validateTRC10ID 檢查資產 ID 格式，TRC10 資產 ID 為數字字串
+func validateTRC10ID(assetID string) error {
+	if id, err := strconv.ParseUint(assetID, 10, 64); err != nil || id == 0 {
+		return fmt.Errorf("invalid TRC10 asset id %q", assetID)
+	}
+	return nil
+}
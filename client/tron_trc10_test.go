@@ -0,0 +1,57 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestTRC10Balance(t *testing.T) {
+	asset := &core.AssetIssueContract{Id: "1002000", Name: []byte("BitTorrent"), Abbr: []byte("BTT"), Precision: 6}
+	b := trc10Balance(asset, 1234500000)
+	if b.AssetID != "1002000" || b.Abbr != "BTT" || b.Balance != 1234.5 || b.RawBalance != "1234500000" {
+		t.Errorf("unexpected balance %+v", b)
+	}
+	if b := trc10Balance(&core.AssetIssueContract{Id: "1000001"}, 42); b.Balance != 42 {
+		t.Errorf("expected precision 0 to keep raw amount, got %+v", b)
+	}
+}
+
+func TestValidateTRC10ID(t *testing.T) {
+	for _, id := range []string{"1002000", "1000001"} {
+		if err := validateTRC10ID(id); err != nil {
+			t.Errorf("expected %s to be valid: %v", id, err)
+		}
+	}
+	for _, id := range []string{"", "0", "BTT", "-1"} {
+		if err := validateTRC10ID(id); err == nil {
+			t.Errorf("expected %q to be invalid", id)
+		}
+	}
+}
+
+func TestTronTransferDeposit_TRC10(t *testing.T) {
+	from, _ := address.Base58ToAddress("TJRabPrwbZy45sbavfcjinPJC18kjpRTv8")
+	to, _ := address.Base58ToAddress("TVjsyZ7fYF3qLF6BQgPmTEZy1xrNNyVAAA")
+	param, err := anypb.New(&core.TransferAssetContract{
+		AssetName:    []byte("1002000"),
+		OwnerAddress: from,
+		ToAddress:    to,
+		Amount:       500,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, ok, err := tronTransferDeposit(&core.Transaction_Contract{Type: core.Transaction_Contract_TransferAssetContract, Parameter: param})
+	if err != nil || !ok {
+		t.Fatalf("expected TRC10 deposit, got ok=%v err=%v", ok, err)
+	}
+	if d.Token != "1002000" || d.From != from.String() || d.To != to.String() || d.Amount != "500" {
+		t.Errorf("unexpected deposit %+v", d)
+	}
+	if _, ok, _ := tronTransferDeposit(&core.Transaction_Contract{Type: core.Transaction_Contract_VoteWitnessContract}); ok {
+		t.Error("expected other contract types to be ignored")
+	}
+}
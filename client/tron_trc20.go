@@ -0,0 +1,187 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+)
+
+// GetTRC20Balance 以 TriggerConstantContract 呼叫 balanceOf，回傳最小單位餘額
+func (t *TronClient) GetTRC20Balance(ctx context.Context, contractAddress, walletAddress string) (*big.Int, error) {
+	if t.client == nil {
+		return nil, errors.New("Tron client not connected")
+	}
+	wallet, err := parseTronAddress(walletAddress)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := parseTronAddress(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	data, err := erc20ABI.Pack("balanceOf", tronToEVMAddress(wallet))
+	if err != nil {
+		return nil, err
+	}
+	out, err := t.triggerConstant(wallet, contract, data)
+	if err != nil {
+		return nil, err
+	}
+	values, err := erc20ABI.Unpack("balanceOf", out)
+	if err != nil {
+		return nil, fmt.Errorf("decode balanceOf result: %w", err)
+	}
+	balance, ok := values[0].(*big.Int)
+	if !ok {
+		return nil, errors.New("unexpected balanceOf result")
+	}
+	return balance, nil
+}
+
+// TransferTRC20 以 TriggerSmartContract 呼叫 transfer，amount 為最小單位
+// 交易 fee_limit 取自 TronConfig.FeeLimit，簽名後廣播並回傳 txid
+func (t *TronClient) TransferTRC20(ctx context.Context, privateKey, contractAddress, toAddress string, amount *big.Int) (string, error) {
+	return t.transferTRC20(ctx, privateKey, contractAddress, toAddress, amount, "")
+}
+
+// transferTRC20 建立 TRC20 transfer 交易，memo 不為空時寫入 raw_data.data
+func (t *TronClient) transferTRC20(ctx context.Context, privateKey, contractAddress, toAddress string, amount *big.Int, memo string) (string, error) {
+	if t.client == nil {
+		return "", errors.New("Tron client not connected")
+	}
+	if amount == nil || amount.Sign() <= 0 {
+		return "", errors.New("amount must be positive")
+	}
+	signer, err := NewTronSigner(privateKey)
+	if err != nil {
+		return "", err
+	}
+	to, err := parseTronAddress(toAddress)
+	if err != nil {
+		return "", err
+	}
+	contract, err := parseTronAddress(contractAddress)
+	if err != nil {
+		return "", err
+	}
+	data, err := erc20ABI.Pack("transfer", tronToEVMAddress(to), amount)
+	if err != nil {
+		return "", err
+	}
+	ext, err := t.client.TRC20Call(signer.Address().String(), contract.String(), hex.EncodeToString(data), false, t.feeLimit())
+	if err != nil {
+		return "", err
+	}
+	if err := setTronMemo(ext, memo); err != nil {
+		return "", err
+	}
+	return t.signAndBroadcast(signer, ext)
+}
+
+// GetTokenBalance 實作 TokenContractManager 介面，依 decimals 換算餘額
+func (t *TronClient) GetTokenBalance(ctx context.Context, contractAddress, walletAddress string) (*big.Float, error) {
+	balance, err := t.GetTRC20Balance(ctx, contractAddress, walletAddress)
+	if err != nil {
+		return nil, err
+	}
+	decimals, err := t.trc20Decimals(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	return fromSmallestUnit(balance, decimals), nil
+}
+
+// SendToken 實作 TokenContractManager 介面，依 decimals 將金額換算為最小單位
+func (t *TronClient) SendToken(ctx context.Context, fromPrivateKey, contractAddress, toAddress string, amount *big.Float) (string, error) {
+	return t.SendTokenWithMemo(ctx, fromPrivateKey, contractAddress, toAddress, amount, "")
+}
+
+// SendTokenWithMemo 實作 MemoTransferer 介面，依 decimals 換算金額後以附帶備註的 TRC20 transfer 發送
+func (t *TronClient) SendTokenWithMemo(ctx context.Context, fromPrivateKey, contractAddress, toAddress string, amount *big.Float, memo string) (string, error) {
+	if t.client == nil {
+		return "", errors.New("Tron client not connected")
+	}
+	decimals, err := t.trc20Decimals(contractAddress)
+	if err != nil {
+		return "", err
+	}
+	value, err := toSmallestUnit(amount, decimals)
+	if err != nil {
+		return "", err
+	}
+	return t.transferTRC20(ctx, fromPrivateKey, contractAddress, toAddress, value, memo)
+}
+
+// trc20Decimals 查詢代幣 decimals
+func (t *TronClient) trc20Decimals(contractAddress string) (uint8, error) {
+	contract, err := parseTronAddress(contractAddress)
+	if err != nil {
+		return 0, err
+	}
+	data, err := erc20ABI.Pack("decimals")
+	if err != nil {
+		return 0, err
+	}
+	out, err := t.triggerConstant(nil, contract, data)
+	if err != nil {
+		return 0, err
+	}
+	values, err := erc20ABI.Unpack("decimals", out)
+	if err != nil {
+		return 0, fmt.Errorf("decode decimals result: %w", err)
+	}
+	decimals, ok := values[0].(uint8)
+	if !ok {
+		return 0, errors.New("unexpected decimals result")
+	}
+	return decimals, nil
+}
+
+// triggerConstant 以 TriggerConstantContract 執行唯讀呼叫，owner 為空時使用零地址
+func (t *TronClient) triggerConstant(owner, contract address.Address, data []byte) ([]byte, error) {
+	from := ""
+	if owner != nil {
+		from = owner.String()
+	}
+	ext, err := t.client.TRC20Call(from, contract.String(), hex.EncodeToString(data), true, 0)
+	if err != nil {
+		return nil, err
+	}
+	if err := tronContractError(ext); err != nil {
+		return nil, err
+	}
+	if len(ext.GetConstantResult()) == 0 {
+		return nil, fmt.Errorf("contract %s returned no result", contract.String())
+	}
+	return ext.GetConstantResult()[0], nil
+}
+
+// tronContractError 檢查唯讀呼叫的執行結果，REVERT 等失敗時回傳錯誤
+func tronContractError(ext *api.TransactionExtention) error {
+	for _, ret := range ext.GetTransaction().GetRet() {
+		if code := ret.GetContractRet(); code != core.Transaction_Result_SUCCESS && code != core.Transaction_Result_DEFAULT {
+			return fmt.Errorf("contract execution failed: %s", code)
+		}
+	}
+	return nil
+}
+
+// feeLimit 回傳合約呼叫的 fee_limit
+func (t *TronClient) feeLimit() int64 {
+	if t.config.FeeLimit > 0 {
+		return t.config.FeeLimit
+	}
+	return DefaultTronFeeLimit
+}
+
+// tronToEVMAddress 去除 0x41 前綴，作為 ABI address 參數
+func tronToEVMAddress(addr address.Address) common.Address {
+	return common.BytesToAddress(addr.Bytes()[1:])
+}
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+)
+
+var _ types.TronVoteManager = (*TronClient)(nil)
+
+// tronMaxVotes 單筆 VoteWitnessContract 最多可投的候選人數
+const tronMaxVotes = 30
+
+// ListWitnesses 實作 TronVoteManager 介面
+func (t *TronClient) ListWitnesses(ctx context.Context) ([]types.TronWitnessResponse, error) {
+	if t.client == nil {
+		return nil, errors.New("Tron client not connected")
+	}
+	list, err := t.client.Client.ListWitnesses(ctx, new(api.EmptyMessage))
+	if err != nil {
+		return nil, err
+	}
+	witnesses := list.GetWitnesses()
+	sort.SliceStable(witnesses, func(i, j int) bool {
+		return witnesses[i].GetVoteCount() > witnesses[j].GetVoteCount()
+	})
+	out := make([]types.TronWitnessResponse, 0, len(witnesses))
+	for i, w := range witnesses {
+		out = append(out, types.TronWitnessResponse{
+			Rank:           i + 1,
+			Address:        address.Address(w.GetAddress()).String(),
+			URL:            w.GetUrl(),
+			VoteCount:      w.GetVoteCount(),
+			TotalProduced:  w.GetTotalProduced(),
+			TotalMissed:    w.GetTotalMissed(),
+			LatestBlockNum: w.GetLatestBlockNum(),
+			IsActive:       w.GetIsJobs(),
+		})
+	}
+	return out, nil
+}
+
+// VoteWitness 實作 TronVoteManager 介面
+// 依傳入順序建立投票，總票數不可超過質押取得的票數，由節點檢查
+func (t *TronClient) VoteWitness(ctx context.Context, privateKey string, votes []types.TronVote) (string, error) {
+	if len(votes) == 0 || len(votes) > tronMaxVotes {
+		return "", fmt.Errorf("expected 1 to %d votes, got %d", tronMaxVotes, len(votes))
+	}
+	contract := &core.VoteWitnessContract{}
+	seen := make(map[string]bool, len(votes))
+	for _, v := range votes {
+		witness, err := parseTronAddress(v.WitnessAddress)
+		if err != nil {
+			return "", err
+		}
+		if seen[witness.String()] {
+			return "", fmt.Errorf("duplicate witness %s", witness.String())
+		}
+		seen[witness.String()] = true
+		if v.Votes <= 0 {
+			return "", fmt.Errorf("witness %s: votes must be positive", witness.String())
+		}
+		contract.Votes = append(contract.Votes, &core.VoteWitnessContract_Vote{VoteAddress: witness.Bytes(), VoteCount: v.Votes})
+	}
+	return t.stakeTx(privateKey, func(owner string) (*api.TransactionExtention, error) {
+		ownerAddr, err := parseTronAddress(owner)
+		if err != nil {
+			return nil, err
+		}
+		contract.OwnerAddress = ownerAddr.Bytes()
+		return t.client.Client.VoteWitnessAccount2(ctx, contract)
+	})
+}
+
+// GetReward 實作 TronVoteManager 介面
+func (t *TronClient) GetReward(ctx context.Context, addr string) (types.TronRewardResponse, error) {
+	if t.client == nil {
+		return types.TronRewardResponse{}, errors.New("Tron client not connected")
+	}
+	tronAddr, err := parseTronAddress(addr)
+	if err != nil {
+		return types.TronRewardResponse{}, err
+	}
+	acc, err := t.client.GetAccount(tronAddr.String())
+	if err != nil {
+		return types.TronRewardResponse{}, err
+	}
+	reward, err := t.client.Client.GetRewardInfo(ctx, &api.BytesMessage{Value: tronAddr.Bytes()})
+	if err != nil {
+		return types.TronRewardResponse{}, err
+	}
+	info := types.TronRewardResponse{
+		Address:            tronAddr.String(),
+		Reward:             reward.GetNum(),
+		VotingPower:        tronVotingPower(acc) / 1_000_000,
+		Votes:              []types.TronVote{},
+		LatestWithdrawTime: acc.GetLatestWithdrawTime(),
+	}
+	for _, v := range acc.GetVotes() {
+		info.UsedVotes += v.GetVoteCount()
+		info.Votes = append(info.Votes, types.TronVote{
+			WitnessAddress: address.Address(v.GetVoteAddress()).String(),
+			Votes:          v.GetVoteCount(),
+		})
+	}
+	return info, nil
+}
+
+// WithdrawReward 實作 TronVoteManager 介面
+func (t *TronClient) WithdrawReward(ctx context.Context, privateKey string) (string, error) {
+	return t.stakeTx(privateKey, func(owner string) (*api.TransactionExtention, error) {
+		ownerAddr, err := parseTronAddress(owner)
+		if err != nil {
+			return nil, err
+		}
+		return t.client.Client.WithdrawBalance2(ctx, &core.WithdrawBalanceContract{OwnerAddress: ownerAddr.Bytes()})
+	})
+}
+
+// tronVotingPower 計算帳戶的投票權（sun），與 java-tron AccountCapsule.getAllTronPower 相同
+// old_tron_power 為 -1 時僅計入 TRON_POWER 類型的質押，0 時計入所有頻寬與能量質押（含已代理）
+func tronVotingPower(acc *core.Account) int64 {
+	power := acc.GetTronPower().GetFrozenBalance()
+	for _, f := range acc.GetFrozenV2() {
+		if f.GetType() == core.ResourceCode_TRON_POWER {
+			power += f.GetAmount()
+		}
+	}
+	switch old := acc.GetOldTronPower(); {
+	case old == -1:
+		return power
+	case old > 0:
+		return power + old
+	}
+	power += acc.GetDelegatedFrozenBalanceForBandwidth() + acc.GetAccountResource().GetDelegatedFrozenBalanceForEnergy()
+	power += acc.GetAccountResource().GetFrozenBalanceForEnergy().GetFrozenBalance()
+	for _, f := range acc.GetFrozen() {
+		power += f.GetFrozenBalance()
+	}
+	power += acc.GetDelegatedFrozenV2BalanceForBandwidth() + acc.GetAccountResource().GetDelegatedFrozenV2BalanceForEnergy()
+	for _, f := range acc.GetFrozenV2() {
+		if f.GetType() == core.ResourceCode_BANDWIDTH || f.GetType() == core.ResourceCode_ENERGY {
+			power += f.GetAmount()
+		}
+	}
+	return power
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blockchain-sdk-go/api/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestTronVotingPower(t *testing.T) {
+	acc := &core.Account{
+		FrozenV2: []*core.Account_FreezeV2{
+			{Type: core.ResourceCode_BANDWIDTH, Amount: 10},
+			{Type: core.ResourceCode_ENERGY, Amount: 200},
+			{Type: core.ResourceCode_TRON_POWER, Amount: 5},
+		},
+		DelegatedFrozenV2BalanceForBandwidth: 4,
+		AccountResource:                      &core.Account_AccountResource{DelegatedFrozenV2BalanceForEnergy: 50},
+	}
+	if got := tronVotingPower(acc); got != 269 {
+		t.Errorf("expected voting power 269, got %d", got)
+	}
+	acc.OldTronPower = -1
+	if got := tronVotingPower(acc); got != 5 {
+		t.Errorf("expected only TRON_POWER stake after old_tron_power -1, got %d", got)
+	}
+	acc.OldTronPower = 100
+	if got := tronVotingPower(acc); got != 105 {
+		t.Errorf("expected recorded old tron power, got %d", got)
+	}
+}
+
+func TestTronClient_VoteValidation(t *testing.T) {
+	c := &TronClient{}
+	witness := "TNPeeaaFB7K9cmo4uQpcU32zGK8G1NYqeL"
+	for name, votes := range map[string][]types.TronVote{
+		"empty":     nil,
+		"duplicate": {{WitnessAddress: witness, Votes: 1}, {WitnessAddress: witness, Votes: 2}},
+		"zero":      {{WitnessAddress: witness, Votes: 0}},
+		"address":   {{WitnessAddress: "not-an-address", Votes: 1}},
+	} {
+		if _, err := c.VoteWitness(context.Background(), "", votes); err == nil {
+			t.Errorf("%s: expected validation error", name)
+		}
+	}
+}
+
+func TestTronClient_VoteAndRewards(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	owner := address.PubkeyToAddress(key.PublicKey)
+	sr1 := address.HexToAddress("41a614f803b6fd780986a42c78ec9c7f77e6ded13c")
+	sr2 := address.HexToAddress("41e552f6487585c2b58bc2c9bb4492bc1f17132cd0")
+
+	var (
+		voted     *core.VoteWitnessContract
+		withdrawn bool
+		broadcast int
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]interface{}
+		_ = json.Unmarshal(body, &req)
+		var resp interface{}
+		switch r.URL.Path {
+		case "/wallet/listwitnesses":
+			resp = map[string]interface{}{"witnesses": []interface{}{
+				map[string]interface{}{"address": hex.EncodeToString(sr2), "voteCount": 10, "url": "https://b"},
+				map[string]interface{}{"address": hex.EncodeToString(sr1), "voteCount": 99, "url": "https://a", "isJobs": true},
+			}}
+		case "/wallet/getaccount":
+			resp = map[string]interface{}{
+				"address":              req["address"],
+				"frozenV2":             []interface{}{map[string]interface{}{"amount": 3_000_000}},
+				"votes":                []interface{}{map[string]interface{}{"vote_address": hex.EncodeToString(sr1), "vote_count": 2}},
+				"latest_withdraw_time": 1700000000000,
+			}
+		case "/wallet/getReward":
+			if req["address"] != hex.EncodeToString(owner) {
+				t.Errorf("unexpected reward address %v", req["address"])
+			}
+			resp = map[string]interface{}{"reward": 1234}
+		case "/wallet/votewitnessaccount", "/wallet/withdrawbalance":
+			var contract proto.Message
+			var typ core.Transaction_Contract_ContractType
+			if r.URL.Path == "/wallet/votewitnessaccount" {
+				voted = new(core.VoteWitnessContract)
+				decodeTestTronJSON(t, body, voted)
+				contract, typ = voted, core.Transaction_Contract_VoteWitnessContract
+			} else {
+				withdrawn = true
+				contract, typ = &core.WithdrawBalanceContract{OwnerAddress: owner}, core.Transaction_Contract_WithdrawBalanceContract
+			}
+			param, _ := anypb.New(contract)
+			tx := testTronTransaction()
+			tx.RawData.Contract = []*core.Transaction_Contract{{Type: typ, Parameter: param}}
+			txJSON := tronJSONMessage(tx.ProtoReflect())
+			txJSON["txID"] = getTronTxID(tx)
+			resp = txJSON
+		case "/wallet/broadcasthex":
+			broadcast++
+			resp = map[string]interface{}{"result": true}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	c := &TronClient{}
+	if err := c.Connect(context.Background(), srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	witnesses, err := c.ListWitnesses(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(witnesses) != 2 || witnesses[0].Address != sr1.String() || witnesses[0].Rank != 1 || !witnesses[0].IsActive {
+		t.Errorf("expected witnesses ranked by votes, got %+v", witnesses)
+	}
+
+	privateKey := hex.EncodeToString(crypto.FromECDSA(key))
+	votes := []types.TronVote{{WitnessAddress: sr2.String(), Votes: 1}, {WitnessAddress: sr1.String(), Votes: 2}}
+	if _, err := c.VoteWitness(context.Background(), privateKey, votes); err != nil {
+		t.Fatal(err)
+	}
+	if voted == nil || !bytes.Equal(voted.GetOwnerAddress(), owner) || len(voted.GetVotes()) != 2 ||
+		!bytes.Equal(voted.GetVotes()[0].GetVoteAddress(), sr2) || voted.GetVotes()[1].GetVoteCount() != 2 {
+		t.Errorf("unexpected vote contract %v", voted)
+	}
+
+	reward, err := c.GetReward(context.Background(), owner.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reward.Reward != 1234 || reward.VotingPower != 3 || reward.UsedVotes != 2 || len(reward.Votes) != 1 || reward.LatestWithdrawTime != 1700000000000 {
+		t.Errorf("unexpected reward %+v", reward)
+	}
+
+	if _, err := c.WithdrawReward(context.Background(), privateKey); err != nil {
+		t.Fatal(err)
+	}
+	if !withdrawn || broadcast != 2 {
+		t.Errorf("expected vote and withdraw to be broadcast, got withdrawn=%v broadcast=%d", withdrawn, broadcast)
+	}
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// fromSmallestUnit 依 decimals 將最小單位換算為一般單位
+func fromSmallestUnit(value *big.Int, decimals uint8) *big.Float {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	return new(big.Float).Quo(new(big.Float).SetInt(value), new(big.Float).SetInt(scale))
+}
+
+// toSmallestUnit 依 decimals 將一般單位換算為最小單位，超出精度的部分捨去
+// 以十進位字串換算，避免 0.3 之類的二進位浮點誤差造成少 1 個最小單位
+func toSmallestUnit(amount *big.Float, decimals uint8) (*big.Int, error) {
+	if amount == nil || amount.Sign() <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+	r, ok := new(big.Rat).SetString(amount.Text('f', -1))
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %s", amount.String())
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	r.Mul(r, new(big.Rat).SetInt(scale))
+	value := new(big.Int).Quo(r.Num(), r.Denom())
+	if value.Sign() <= 0 {
+		return nil, fmt.Errorf("amount is below the token precision of %d decimals", decimals)
+	}
+	return value, nil
+}
+
+// parseSmallestUnit 解析以最小單位表示的十進位整數金額，須為正數
+func parseSmallestUnit(amount string) (*big.Int, error) {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok || value.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid amount %q: expected a positive integer in the smallest unit", amount)
+	}
+	return value, nil
+}
+
+// parseSunAmount 解析以 sun 計的 TRX 金額，須為正整數且不超過 int64
+func parseSunAmount(amount string) (int64, error) {
+	value, err := parseSmallestUnit(amount)
+	if err != nil {
+		return 0, err
+	}
+	if !value.IsInt64() {
+		return 0, fmt.Errorf("amount %s overflows int64", amount)
+	}
+	return value.Int64(), nil
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestToSmallestUnit(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		decimals uint8
+		want     string
+	}{
+		{0.3, 6, "300000"},
+		{1.1, 6, "1100000"},
+		{12.3456789, 6, "12345678"}, // 超出精度捨去
+		{1, 18, "1000000000000000000"},
+		{100, 0, "100"},
+	}
+	for _, tt := range tests {
+		got, err := toSmallestUnit(big.NewFloat(tt.amount), tt.decimals)
+		if err != nil {
+			t.Errorf("toSmallestUnit(%v, %d) failed: %v", tt.amount, tt.decimals, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("toSmallestUnit(%v, %d) = %s, want %s", tt.amount, tt.decimals, got, tt.want)
+		}
+	}
+	if _, err := toSmallestUnit(big.NewFloat(0.0000001), 6); err == nil {
+		t.Error("expected error for amount below token precision")
+	}
+	if _, err := toSmallestUnit(big.NewFloat(-1), 6); err == nil {
+		t.Error("expected error for negative amount")
+	}
+}
+
+func TestFromSmallestUnit(t *testing.T) {
+	got, _ := fromSmallestUnit(big.NewInt(1234567), 6).Float64()
+	if got != 1.234567 {
+		t.Errorf("expected 1.234567, got %v", got)
+	}
+}
+
+func TestParseSmallestUnit(t *testing.T) {
+	got, err := parseSmallestUnit("1000000000000000000000")
+	if err != nil || got.String() != "1000000000000000000000" {
+		t.Errorf("parseSmallestUnit = %v, %v", got, err)
+	}
+	// 小數、零與負數皆拒絕，不可截斷為 0
+	for _, amount := range []string{"0.5", "0", "-1", "1e6", ""} {
+		if _, err := parseSmallestUnit(amount); err == nil {
+			t.Errorf("parseSmallestUnit(%q): expected error", amount)
+		}
+	}
+}
+
+func TestParseSunAmount(t *testing.T) {
+	if got, err := parseSunAmount("2000000"); err != nil || got != 2000000 {
+		t.Errorf("parseSunAmount = %d, %v", got, err)
+	}
+	if _, err := parseSunAmount("9223372036854775808"); err == nil {
+		t.Error("expected overflow error")
+	}
+}
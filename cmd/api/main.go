@@ -35,43 +35,30 @@ func main() {
 		loggerInstance.Info("No .env file found, using system environment variables")
 	}
 
-	ethNodeURL := os.Getenv("ETH_NODE_URL")
-	tronNodeURL := os.Getenv("TRON_NODE_URL")
-
 	loggerInstance.Info("Starting Blockchain SDK API service")
 
-	// Create Ethereum handler
-	ethHandler, err := handler.NewBlockchainHandler(client.Ethereum, ethNodeURL)
-	if err != nil {
-		loggerInstance.Errorf("Failed to create Ethereum handler: %v", err)
-		log.Fatalf("Failed to create Ethereum handler: %v", err)
-	}
-
-	// 自动连接以太坊节点
-	if ethNodeURL != "" {
-		loggerInstance.Infof("Auto-connecting Ethereum node: %s", ethNodeURL)
-		if err := ethHandler.ConnectByURL(ethNodeURL); err != nil {
-			loggerInstance.Errorf("Failed to auto-connect Ethereum node: %v", err)
-			log.Fatalf("Failed to auto-connect Ethereum node: %v", err)
+	// 依註冊表建立各區塊鏈實例的 handler，每個實例一個路由群組（EVM 每個網路一個實例）
+	var groups []routeGroup
+	for _, chain := range client.Chains() {
+		instances, err := chain.NewInstances()
+		if err != nil {
+			loggerInstance.Errorf("Failed to create %s instances: %v", chain.Type, err)
+			log.Fatalf("Failed to create %s instances: %v", chain.Type, err)
 		}
-		loggerInstance.Info("Successfully connected to Ethereum node")
-	}
-
-	// Create Tron handler
-	tronHandler, err := handler.NewBlockchainHandler(client.Tron, tronNodeURL)
-	if err != nil {
-		loggerInstance.Errorf("Failed to create Tron handler: %v", err)
-		log.Fatalf("Failed to create Tron handler: %v", err)
-	}
-
-	// 自动连接波场节点
-	if tronNodeURL != "" {
-		loggerInstance.Infof("Auto-connecting Tron node: %s", tronNodeURL)
-		if err := tronHandler.ConnectByURL(tronNodeURL); err != nil {
-			loggerInstance.Errorf("Failed to auto-connect Tron node: %v", err)
-			log.Fatalf("Failed to auto-connect Tron node: %v", err)
+		for _, instance := range instances {
+			h := handler.NewInstanceHandler(instance)
+
+			// 自动连接已設定節點位址的實例
+			if instance.AutoConnect {
+				loggerInstance.Infof("Auto-connecting %s node", instance.Name)
+				if err := h.AutoConnect(); err != nil {
+					loggerInstance.Errorf("Failed to auto-connect %s node: %v", instance.Name, err)
+					log.Fatalf("Failed to auto-connect %s node: %v", instance.Name, err)
+				}
+				loggerInstance.Infof("Successfully connected to %s node", instance.Name)
+			}
+			groups = append(groups, routeGroup{name: instance.Name, handler: h})
 		}
-		loggerInstance.Info("Successfully connected to Tron node")
 	}
 
 	// Initialize Gin router
@@ -92,25 +79,17 @@ func main() {
 	// API version group
 	v1 := r.Group("/api/v1")
 	{
-		// Ethereum routes
-		eth := v1.Group("/eth")
-		{
-			eth.POST("/connect", ethHandler.Connect)
-			eth.POST("/wallet/generate", ethHandler.GenerateWallet)
-			eth.POST("/balance", ethHandler.GetBalance)
-			eth.POST("/transfer/native", ethHandler.SendNativeToken)
-			eth.POST("/contract/deploy", ethHandler.DeployContract)
-		}
+		allHandlers := make([]*handler.BlockchainHandler, 0, len(groups))
 
-		// Tron routes
-		tron := v1.Group("/tron")
-		{
-			tron.POST("/connect", tronHandler.Connect)
-			tron.POST("/wallet/generate", tronHandler.GenerateWallet)
-			tron.POST("/balance", tronHandler.GetBalance)
-			tron.POST("/transfer/native", tronHandler.SendNativeToken)
-			tron.POST("/contract/deploy", tronHandler.DeployContract)
+		// 每個網路一個路由群組：/api/v1/{network}/...，client 未提供的功能回應 501
+		for _, g := range groups {
+			handler.RegisterRoutes(v1.Group("/"+g.name), g.handler)
+			allHandlers = append(allHandlers, g.handler)
 		}
+
+		networkHandler := handler.NewNetworkHandler(allHandlers...)
+		v1.GET("/networks", networkHandler.ListNetworks)
+		v1.GET("/chains", networkHandler.ListChains)
 	}
 
 	// Swagger documentation
@@ -133,14 +112,16 @@ func main() {
 	loggerInstance.Info("Shutting down server...")
 
 	// 关闭区块链连接
-	if ethHandler != nil {
-		ethHandler.Close()
-		loggerInstance.Info("Ethereum connection closed")
-	}
-	if tronHandler != nil {
-		tronHandler.Close()
-		loggerInstance.Info("Tron connection closed")
+	for _, g := range groups {
+		g.handler.Close()
+		loggerInstance.Infof("%s connection closed", g.name)
 	}
 
 	loggerInstance.Info("Server shutdown complete")
 }
+
+// routeGroup 單一路由群組，name 為路由前綴
+type routeGroup struct {
+	name    string
+	handler *handler.BlockchainHandler
+}
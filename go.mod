@@ -9,12 +9,14 @@ require (
 	github.com/fbsobreira/gotron-sdk v0.24.0
 	github.com/gin-gonic/gin v1.9.1
 	github.com/golang/protobuf v1.5.4
+	github.com/grafana/loki/clients/pkg/promtail/client v0.0.0-20231214180000-0c4b3b3b3b3b
 	github.com/joho/godotenv v1.5.1
 	github.com/sirupsen/logrus v1.9.3
 	github.com/swaggo/files v1.0.1
 	github.com/swaggo/gin-swagger v1.6.0
 	github.com/swaggo/swag v1.16.3
-	github.com/grafana/loki/clients/pkg/promtail/client v0.0.0-20231214180000-0c4b3b3b3b3b
+	google.golang.org/grpc v1.71.0
+	google.golang.org/protobuf v1.36.6
 )
 
 require (
@@ -83,8 +85,6 @@ require (
 	golang.org/x/text v0.23.0 // indirect
 	golang.org/x/tools v0.29.0 // indirect
 	google.golang.org/genproto v0.0.0-20200825200019-8632dd797987 // indirect
-	google.golang.org/grpc v1.71.0 // indirect
-	google.golang.org/protobuf v1.36.6 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 	rsc.io/tmplfunc v0.0.3 // indirect